@@ -15,6 +15,6 @@ require (
 	github.com/pkg/errors v0.9.1
 	github.com/stretchr/testify v1.6.1
 	go.deanishe.net/fuzzy v1.0.0
-	golang.org/x/image v0.0.0-20200801110659-972c09e46d76 // indirect
-	golang.org/x/net v0.0.0-20200822124328-c89045814202 // indirect
+	golang.org/x/image v0.0.0-20200801110659-972c09e46d76
+	golang.org/x/net v0.0.0-20200822124328-c89045814202
 )
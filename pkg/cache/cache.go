@@ -0,0 +1,392 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+// Package cache stores Goodreads data on disk.
+//
+// Data are cached as JSON-serialised gr types, so the cache has a schema
+// version. When a change to the gr types alters what is cached, increment
+// Version and, if old data can be converted, add a migration. Data that
+// can't be migrated are deleted when the cache is opened.
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/natefinch/atomic"
+	"github.com/pkg/errors"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+// Version is the current schema version of cached data.
+//
+// 1: Series positions, multiple series & publication date precision.
+// 2: Exclusive shelves.
+// 3: Dates books were added to and last updated on shelves.
+const Version = 3
+
+// name of file containing schema version
+const versionFile = "schema.json"
+
+// migrations convert cached data to the next schema version,
+// keyed by the version they convert from.
+var migrations = map[int]func(c *Cache) error{}
+
+// Cache contains the typed data stores.
+type Cache struct {
+	Dir      string
+	Authors  Authors
+	Books    Books
+	Searches Searches
+	Series   Series
+	Shelves  Shelves
+}
+
+// Open opens the cache in directory dir, migrating or deleting any data
+// saved with a different schema version.
+func Open(dir string) (*Cache, error) {
+	shelves := filepath.Join(dir, "shelves")
+	c := &Cache{
+		Dir:      dir,
+		Authors:  Authors{store{filepath.Join(dir, "authors")}},
+		Books:    Books{store{filepath.Join(dir, "books")}},
+		Searches: Searches{store{filepath.Join(dir, "queries")}},
+		Series:   Series{store{filepath.Join(dir, "series")}},
+		Shelves:  Shelves{store{shelves}, shelves},
+	}
+
+	v, err := c.version()
+	if err != nil {
+		return nil, err
+	}
+	if v != Version {
+		if err := c.migrate(v); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range c.stores() {
+		if err := os.MkdirAll(s.dir, 0700); err != nil {
+			return nil, errors.Wrap(err, "create cache directory")
+		}
+	}
+	return c, nil
+}
+
+// Dirs returns the directories of the typed stores.
+func (c *Cache) Dirs() []string {
+	var dirs []string
+	for _, s := range c.stores() {
+		dirs = append(dirs, s.dir)
+	}
+	return dirs
+}
+
+func (c *Cache) stores() []store {
+	return []store{c.Authors.store, c.Books.store, c.Searches.store, c.Series.store, c.Shelves.store}
+}
+
+// read schema version of cached data. Data without a version stamp predate
+// versioning and are version 0.
+func (c *Cache) version() (int, error) {
+	var v struct{ Version int }
+	data, err := ioutil.ReadFile(filepath.Join(c.Dir, versionFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "read cache version")
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		// unreadable stamp: treat data as unversioned
+		return 0, nil
+	}
+	return v.Version, nil
+}
+
+// upgrade cached data from version to current Version. If there is no
+// migration, or a migration fails, cached data are deleted.
+func (c *Cache) migrate(version int) error {
+	v := version
+	for v < Version {
+		m, ok := migrations[v]
+		if !ok || m(c) != nil {
+			break
+		}
+		v++
+	}
+	if v != Version {
+		if err := c.drop(); err != nil {
+			return err
+		}
+	}
+
+	data, _ := json.Marshal(struct{ Version int }{Version})
+	if err := atomic.WriteFile(filepath.Join(c.Dir, versionFile), bytes.NewReader(data)); err != nil {
+		return errors.Wrap(err, "save cache version")
+	}
+	return nil
+}
+
+// delete all cached data.
+func (c *Cache) drop() error {
+	for _, s := range c.stores() {
+		if err := os.RemoveAll(s.dir); err != nil {
+			return errors.Wrap(err, "delete cached data")
+		}
+	}
+	if err := os.Remove(c.Shelves.listPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "delete cached data")
+	}
+	return nil
+}
+
+// Authors stores books by author.
+type Authors struct{ store }
+
+// Load returns books by author.
+func (s Authors) Load(id int64) (books []gr.Book, err error) {
+	err = s.load(idPath(id), &books)
+	return
+}
+
+// Save saves books by author.
+func (s Authors) Save(id int64, books []gr.Book) error { return s.save(idPath(id), books) }
+
+// Exists returns true if books by author are cached.
+func (s Authors) Exists(id int64) bool { return s.exists(idPath(id)) }
+
+// Expired returns true if books by author are not cached or older than maxAge.
+func (s Authors) Expired(id int64, maxAge time.Duration) bool {
+	return s.expired(idPath(id), maxAge)
+}
+
+// Books stores book details.
+type Books struct{ store }
+
+// Load returns book details.
+func (s Books) Load(id int64) (b gr.Book, err error) {
+	err = s.load(idPath(id), &b)
+	return
+}
+
+// Save saves book details.
+func (s Books) Save(id int64, b gr.Book) error { return s.save(idPath(id), b) }
+
+// Exists returns true if book details are cached.
+func (s Books) Exists(id int64) bool { return s.exists(idPath(id)) }
+
+// Expired returns true if book details are not cached or older than maxAge.
+func (s Books) Expired(id int64, maxAge time.Duration) bool {
+	return s.expired(idPath(id), maxAge)
+}
+
+// Searches stores search results.
+type Searches struct{ store }
+
+// Load returns results for query.
+func (s Searches) Load(query string) (books []gr.Book, err error) {
+	err = s.load(hashPath(query), &books)
+	return
+}
+
+// Save saves results for query.
+func (s Searches) Save(query string, books []gr.Book) error { return s.save(hashPath(query), books) }
+
+// Expired returns true if results for query are not cached or older than maxAge.
+func (s Searches) Expired(query string, maxAge time.Duration) bool {
+	return s.expired(hashPath(query), maxAge)
+}
+
+// Series stores series.
+type Series struct{ store }
+
+// Load returns series.
+func (s Series) Load(id int64) (series gr.Series, err error) {
+	err = s.load(idPath(id), &series)
+	return
+}
+
+// Save saves series.
+func (s Series) Save(id int64, series gr.Series) error { return s.save(idPath(id), series) }
+
+// Exists returns true if series is cached.
+func (s Series) Exists(id int64) bool { return s.exists(idPath(id)) }
+
+// Expired returns true if series is not cached or older than maxAge.
+func (s Series) Expired(id int64, maxAge time.Duration) bool {
+	return s.expired(idPath(id), maxAge)
+}
+
+// Shelves stores the user's shelves and the books on them. Shelves in
+// position order are in the root directory, other orders in subdirectories.
+type Shelves struct {
+	store
+	root string
+}
+
+// Sorted returns the store for shelves in the given order.
+func (s Shelves) Sorted(order gr.ShelfSort) Shelves {
+	if order == "" || order == gr.SortPosition {
+		return Shelves{store{s.root}, s.root}
+	}
+	return Shelves{store{filepath.Join(s.root, string(order))}, s.root}
+}
+
+// Load returns shelf with books.
+func (s Shelves) Load(name string) (shelf gr.Shelf, err error) {
+	err = s.load(name+".json", &shelf)
+	return
+}
+
+// Save saves shelf with books.
+func (s Shelves) Save(name string, shelf gr.Shelf) error { return s.save(name+".json", shelf) }
+
+// Exists returns true if shelf is cached.
+func (s Shelves) Exists(name string) bool { return s.exists(name + ".json") }
+
+// Expired returns true if shelf is not cached or older than maxAge.
+func (s Shelves) Expired(name string, maxAge time.Duration) bool {
+	return s.expired(name+".json", maxAge)
+}
+
+// Delete deletes cached shelf.
+func (s Shelves) Delete(name string) error {
+	if err := os.Remove(s.path(name + ".json")); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "delete shelf")
+	}
+	return nil
+}
+
+// Names returns the names of cached shelves.
+func (s Shelves) Names() ([]string, error) {
+	infos, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "read shelves")
+	}
+	var names []string
+	for _, fi := range infos {
+		if x := filepath.Ext(fi.Name()); x == ".json" && !fi.IsDir() {
+			names = append(names, strings.TrimSuffix(fi.Name(), x))
+		}
+	}
+	return names, nil
+}
+
+// the list of shelves lives in the cache root for compatibility
+func (s Shelves) listPath() string { return filepath.Join(filepath.Dir(s.root), "shelves.json") }
+
+// List returns the list of shelves (without books).
+func (s Shelves) List() (shelves []gr.Shelf, err error) {
+	err = loadFile(s.listPath(), &shelves)
+	return
+}
+
+// SaveList saves the list of shelves.
+func (s Shelves) SaveList(shelves []gr.Shelf) error { return saveFile(s.listPath(), shelves) }
+
+// ListExists returns true if the list of shelves is cached.
+func (s Shelves) ListExists() bool { return fileExists(s.listPath()) }
+
+// ListExpired returns true if the list of shelves is not cached or older than maxAge.
+func (s Shelves) ListExpired(maxAge time.Duration) bool { return fileExpired(s.listPath(), maxAge) }
+
+// Clear deletes all shelves. Clearing the root store also deletes
+// the list of shelves and all sort orders.
+func (s Shelves) Clear() error {
+	if s.dir == s.root {
+		if err := os.Remove(s.listPath()); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "clear cache")
+		}
+	}
+	return s.store.Clear()
+}
+
+// store is a directory of JSON files.
+type store struct {
+	dir string
+}
+
+// Dir returns the store's directory.
+func (s store) Dir() string { return s.dir }
+
+// Clear deletes all entries in the store.
+func (s store) Clear() error {
+	if err := os.RemoveAll(s.dir); err != nil {
+		return errors.Wrap(err, "clear cache")
+	}
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return errors.Wrap(err, "clear cache")
+	}
+	return nil
+}
+
+func (s store) path(name string) string { return filepath.Join(s.dir, name) }
+
+func (s store) exists(name string) bool { return fileExists(s.path(name)) }
+
+func (s store) expired(name string, maxAge time.Duration) bool {
+	return fileExpired(s.path(name), maxAge)
+}
+
+func (s store) load(name string, v interface{}) error { return loadFile(s.path(name), v) }
+
+func (s store) save(name string, v interface{}) error { return saveFile(s.path(name), v) }
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func fileExpired(path string, maxAge time.Duration) bool {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return true
+	}
+	return time.Since(fi.ModTime()) > maxAge
+}
+
+func loadFile(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrap(err, "read cache file")
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errors.Wrap(err, "decode cache file")
+	}
+	return nil
+}
+
+func saveFile(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "encode cache file")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrap(err, "create cache directory")
+	}
+	if err := atomic.WriteFile(path, bytes.NewReader(data)); err != nil {
+		return errors.Wrap(err, "write cache file")
+	}
+	return nil
+}
+
+// path of file for an ID, e.g. 12/34/123456.json
+func idPath(id int64) string {
+	s := fmt.Sprintf("%04d", id)
+	return fmt.Sprintf("%s/%s/%d.json", s[0:2], s[2:4], id)
+}
+
+// path of file for a string key, e.g. ab/cd/abcdef….json
+func hashPath(key string) string {
+	s := fmt.Sprintf("%x", sha256.Sum256([]byte(key)))
+	return fmt.Sprintf("%s/%s/%s.json", s[0:2], s[2:4], s)
+}
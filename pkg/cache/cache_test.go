@@ -0,0 +1,139 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+// TestStores saves and loads typed data
+func TestStores(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "cache-")
+	require.Nil(t, err, "create temp dir")
+	defer os.RemoveAll(dir)
+
+	c, err := Open(dir)
+	require.Nil(t, err, "open cache")
+
+	book := gr.Book{ID: 12, Title: "Dune"}
+	assert.False(t, c.Books.Exists(12), "book exists")
+	assert.True(t, c.Books.Expired(12, time.Hour), "missing book not expired")
+	require.Nil(t, c.Books.Save(12, book), "save book")
+	assert.True(t, c.Books.Exists(12), "book does not exist")
+	assert.False(t, c.Books.Expired(12, time.Hour), "book expired")
+	assert.True(t, fileExists(filepath.Join(dir, "books/00/12/12.json")), "unexpected book path")
+	b, err := c.Books.Load(12)
+	assert.Nil(t, err, "load book")
+	assert.Equal(t, book, b, "unexpected book")
+
+	books := []gr.Book{book}
+	require.Nil(t, c.Searches.Save("dune", books), "save search")
+	res, err := c.Searches.Load("dune")
+	assert.Nil(t, err, "load search")
+	assert.Equal(t, books, res, "unexpected results")
+	_, err = c.Searches.Load("emma")
+	assert.NotNil(t, err, "load missing search")
+
+	shelves := []gr.Shelf{{ID: 1, Name: "to-read"}}
+	require.Nil(t, c.Shelves.SaveList(shelves), "save shelves")
+	assert.True(t, fileExists(filepath.Join(dir, "shelves.json")), "unexpected shelves path")
+	l, err := c.Shelves.List()
+	assert.Nil(t, err, "load shelves")
+	assert.Equal(t, shelves, l, "unexpected shelves")
+
+	require.Nil(t, c.Shelves.Save("read", gr.Shelf{Name: "read"}), "save shelf")
+	require.Nil(t, c.Shelves.Save("to-read", gr.Shelf{Name: "to-read"}), "save shelf")
+	names, err := c.Shelves.Names()
+	assert.Nil(t, err, "list shelf names")
+	assert.Equal(t, []string{"read", "to-read"}, names, "unexpected shelf names")
+	require.Nil(t, c.Shelves.Delete("read"), "delete shelf")
+	assert.False(t, c.Shelves.Exists("read"), "deleted shelf exists")
+	assert.Nil(t, c.Shelves.Delete("read"), "delete missing shelf")
+
+	byDate := c.Shelves.Sorted(gr.SortDateAdded)
+	require.Nil(t, byDate.Save("to-read", gr.Shelf{Name: "to-read"}), "save sorted shelf")
+	assert.True(t, fileExists(filepath.Join(dir, "shelves/date_added/to-read.json")), "unexpected sorted shelf path")
+	assert.Equal(t, c.Shelves.Dir(), byDate.Sorted(gr.SortPosition).Dir(), "unexpected position store")
+	names, err = c.Shelves.Names()
+	assert.Nil(t, err, "list shelf names")
+	assert.Equal(t, []string{"to-read"}, names, "sorted shelf in root")
+	require.Nil(t, byDate.Clear(), "clear sorted shelves")
+	assert.False(t, byDate.Exists("to-read"), "sorted shelf exists")
+	assert.True(t, c.Shelves.ListExists(), "shelf list deleted")
+	require.Nil(t, byDate.Save("to-read", gr.Shelf{Name: "to-read"}), "save sorted shelf")
+
+	require.Nil(t, c.Shelves.Clear(), "clear shelves")
+	assert.False(t, byDate.Exists("to-read"), "sorted shelf exists")
+	assert.False(t, c.Shelves.Exists("to-read"), "shelf exists")
+	assert.False(t, c.Shelves.ListExists(), "shelf list exists")
+	assert.True(t, c.Books.Exists(12), "book deleted")
+
+	require.Nil(t, c.Books.Clear(), "clear books")
+	assert.False(t, c.Books.Exists(12), "book exists")
+	assert.True(t, fileExists(c.Books.Dir()), "books directory deleted")
+}
+
+// TestVersion drops or migrates data saved with a different schema version
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		stamp   string // contents of version file; empty = no file
+		migrate bool   // whether to add migration from previous version
+		kept    bool   // whether data survive
+	}{
+		{"current", `{"Version":3}`, false, true},
+		{"previous", `{"Version":2}`, false, false},
+		{"unversioned", "", false, false},
+		{"invalid", "garbage", false, false},
+		{"newer", `{"Version":100}`, false, false},
+		{"migrated", `{"Version":2}`, true, true},
+	}
+
+	for _, td := range tests {
+		td := td
+		t.Run(td.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "cache-")
+			require.Nil(t, err, "create temp dir")
+			defer os.RemoveAll(dir)
+
+			c, err := Open(dir)
+			require.Nil(t, err, "open cache")
+			require.Nil(t, c.Books.Save(12, gr.Book{ID: 12}), "save book")
+			require.Nil(t, c.Shelves.SaveList([]gr.Shelf{{ID: 1}}), "save shelves")
+
+			path := filepath.Join(dir, versionFile)
+			if td.stamp == "" {
+				require.Nil(t, os.Remove(path), "delete version")
+			} else {
+				require.Nil(t, ioutil.WriteFile(path, []byte(td.stamp), 0600), "write version")
+			}
+
+			var migrated bool
+			if td.migrate {
+				migrations[Version-1] = func(c *Cache) error { migrated = true; return nil }
+				defer delete(migrations, Version-1)
+			}
+
+			c, err = Open(dir)
+			require.Nil(t, err, "reopen cache")
+			assert.Equal(t, td.migrate, migrated, "unexpected migration")
+			assert.Equal(t, td.kept, c.Books.Exists(12), "unexpected book")
+			assert.Equal(t, td.kept, c.Shelves.ListExists(), "unexpected shelves")
+
+			v, err := c.version()
+			assert.Nil(t, err, "read version")
+			assert.Equal(t, Version, v, "unexpected version")
+		})
+	}
+}
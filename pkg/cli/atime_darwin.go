@@ -0,0 +1,23 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"os"
+	"syscall"
+	"time"
+)
+
+// last access time of file, or its modification time if that's later.
+func accessTime(fi os.FileInfo) time.Time {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fi.ModTime()
+	}
+	t := time.Unix(int64(st.Atimespec.Sec), int64(st.Atimespec.Nsec))
+	if t.Before(fi.ModTime()) {
+		return fi.ModTime()
+	}
+	return t
+}
@@ -0,0 +1,15 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+//go:build !darwin && !linux
+// +build !darwin,!linux
+
+package cli
+
+import (
+	"os"
+	"time"
+)
+
+// modification time of file. Access times aren't available on this platform.
+func accessTime(fi os.FileInfo) time.Time { return fi.ModTime() }
@@ -5,14 +5,14 @@
 package cli
 
 import (
+	"fmt"
 	"log"
-	"path/filepath"
 	"time"
 
 	aw "github.com/deanishe/awgo"
-	"github.com/deanishe/awgo/util"
 
 	"go.deanishe.net/alfred-booksearch/pkg/gr"
+	"go.deanishe.net/alfred-booksearch/pkg/jobs"
 )
 
 // show books by author
@@ -26,19 +26,21 @@ func runAuthor() {
 
 	var (
 		books []gr.Book
-		key   = "authors/" + cachefileID(opts.AuthorID)
-		rerun = wf.IsRunning(booksJob)
+		job   = jobs.Name(booksJob, opts.AuthorID)
+		rerun = wf.IsRunning(job)
 	)
 
-	if wf.Cache.Expired(key, opts.MaxCache.Search) {
+	if caches.Authors.Expired(opts.AuthorID, opts.MaxCache.Search) {
 		rerun = true
-		if err := runJob(booksJob, "-savebooks"); err != nil {
+		if err := runAPIJob(job, "-savebooks", fmt.Sprintf("%d", opts.AuthorID)); err != nil {
 			wf.FatalError(err)
 		}
 	}
 
-	if wf.Cache.Exists(key) {
-		checkErr(wf.Cache.LoadJSON(key, &books))
+	if caches.Authors.Exists(opts.AuthorID) {
+		var err error
+		books, err = caches.Authors.Load(opts.AuthorID)
+		checkErr(err)
 		log.Printf("loaded %d book(s) from cache", len(books))
 	} else {
 		wf.NewItem("Loading Books…").
@@ -54,14 +56,20 @@ func runAuthor() {
 		mods  = LoadModifiers()
 	)
 
+	// don't let Alfred re-order books filtered by query
+	books, text := filterBooks(books, opts.Query)
+	if text == "" && !opts.QueryEmpty() {
+		wf.Configure(aw.SuppressUIDs(true))
+	}
+
 	for _, b := range books {
 		bookItem(b, icons, mods)
 	}
 
 	addNavActions()
 
-	if !opts.QueryEmpty() {
-		wf.Filter(opts.Query)
+	if text != "" {
+		wf.Filter(text)
 	}
 
 	wf.WarnEmpty("No Matching Books", "Try a different query?")
@@ -89,7 +97,6 @@ func runCacheAuthorList() {
 	}
 
 	var (
-		key        = "authors/" + cachefileID(opts.AuthorID)
 		page       = 1
 		pageCount  int
 		books, res []gr.Book
@@ -100,11 +107,9 @@ func runCacheAuthorList() {
 		writePartial bool
 		err          error
 	)
-	util.MustExist(filepath.Dir(filepath.Join(wf.CacheDir(), key)))
-	log.Printf("[authors] caching books by %q (%d) ...", opts.AuthorName, opts.AuthorID)
-	// log.Printf("[authors] cache: %s", key)
-
-	writePartial = !wf.Cache.Exists(key)
+	opts.AuthorID = argID(0)
+	log.Printf("[authors] caching books by author %d ...", opts.AuthorID)
+	writePartial = !caches.Authors.Exists(opts.AuthorID)
 
 	for {
 		if pageCount > 0 && page > pageCount {
@@ -133,11 +138,11 @@ func runCacheAuthorList() {
 		}
 		books = append(books, res...)
 		if writePartial {
-			checkErr(wf.Cache.StoreJSON(key, books))
+			checkErr(caches.Authors.Save(opts.AuthorID, books))
 		}
-		log.Printf("[authors] cached page %d/%d, %d book(s) for %q", page, pageCount, len(books), opts.AuthorName)
+		log.Printf("[authors] cached page %d/%d, %d book(s) by author %d", page, pageCount, len(books), opts.AuthorID)
 		page++
 	}
 
-	checkErr(wf.Cache.StoreJSON(key, books))
+	checkErr(caches.Authors.Save(opts.AuthorID, books))
 }
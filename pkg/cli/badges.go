@@ -0,0 +1,191 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	"github.com/natefinch/atomic"
+	"github.com/pkg/errors"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+// badgeShelf is a shelf whose books get a corner badge.
+type badgeShelf struct {
+	name   string
+	label  string
+	colour color.Color
+}
+
+// shelves whose books get a badge, in order of precedence
+var badgeShelves = []badgeShelf{
+	{"currently-reading", "reading", color.NRGBA{41, 98, 255, 230}},
+	{"read", "read", color.NRGBA{46, 125, 50, 230}},
+	{"to-read", "to-read", color.NRGBA{239, 108, 0, 230}},
+}
+
+// returns name of the badge shelf book is on, or "" if it's on none.
+func badgeStatus(id int64) string {
+	for _, s := range badgeShelves {
+		if onShelf(id, s.name) {
+			return s.name
+		}
+	}
+	return ""
+}
+
+// returns path of a copy of cover with shelf and rating badges. Badged
+// covers are cached alongside the cover and re-rendered if it changes.
+func (c *iconCache) badgedCover(cover string, b gr.Book) (string, error) {
+	var (
+		status = badgeStatus(b.ID)
+		rating = int(math.Round(b.Rating * 10))
+	)
+	if status == "" && rating == 0 {
+		return cover, nil
+	}
+	if status == "" {
+		status = "none"
+	}
+
+	path := badgedPath(cover, status, rating)
+	ci, err := os.Stat(cover)
+	if err != nil {
+		return "", errors.Wrap(err, "read cover")
+	}
+	if fi, err := os.Stat(path); err == nil && !fi.ModTime().Before(ci.ModTime()) {
+		touchCover(path)
+		return path, nil
+	}
+
+	src, err := imaging.Open(cover)
+	if err != nil {
+		return "", errors.Wrap(err, "read cover")
+	}
+	img := imaging.Clone(src)
+	for _, s := range badgeShelves {
+		if s.name == status {
+			drawBadge(img, s.label, s.colour)
+		}
+	}
+	if rating > 0 {
+		drawRating(img, b.Rating)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, img); err != nil {
+		return "", errors.Wrap(err, "encode badged cover")
+	}
+	if err := atomic.WriteFile(path, buf); err != nil {
+		return "", errors.Wrap(err, "save badged cover")
+	}
+	removeBadged(cover, path)
+	return path, nil
+}
+
+// path of cover with badges for shelf status and rating (x10).
+func badgedPath(cover, status string, rating int) string {
+	return fmt.Sprintf("%s.%s-%d.png", strings.TrimSuffix(cover, ".png"), status, rating)
+}
+
+// delete badged copies of cover other than keep, which are left over from
+// the book's previous shelf status or rating.
+func removeBadged(cover, keep string) {
+	var (
+		base     = strings.TrimSuffix(cover, ".png")
+		statuses = []string{"none"}
+	)
+	for _, s := range badgeShelves {
+		statuses = append(statuses, regexp.QuoteMeta(s.name))
+	}
+	rx := regexp.MustCompile(`^` + regexp.QuoteMeta(base) + `\.(` + strings.Join(statuses, "|") + `)-\d+\.png$`)
+
+	paths, err := filepath.Glob(base + ".*.png")
+	if err != nil {
+		log.Printf("[icons] find badged covers: %v", err)
+		return
+	}
+	for _, p := range paths {
+		if p != keep && rx.MatchString(p) {
+			logIfError(os.Remove(p), "delete badged cover: %v")
+		}
+	}
+}
+
+// draw a label in the top-left corner of img.
+func drawBadge(img *image.NRGBA, label string, bg color.Color) {
+	var (
+		face = basicfont.Face7x13
+		d    = &font.Drawer{Dst: img, Src: image.White, Face: face}
+		r    = image.Rect(0, 0, d.MeasureString(label).Ceil()+8, face.Height+4)
+	)
+	draw.Draw(img, r, image.NewUniform(bg), image.Point{}, draw.Over)
+	d.Dot = fixed.P(4, 2+face.Ascent)
+	d.DrawString(label)
+}
+
+// draw a ribbon showing a star and rating across the bottom of img.
+func drawRating(img *image.NRGBA, rating float64) {
+	var (
+		face   = basicfont.Face7x13
+		bounds = img.Bounds()
+		height = face.Height + 4
+		r      = image.Rect(bounds.Min.X, bounds.Max.Y-height, bounds.Max.X, bounds.Max.Y)
+		text   = fmt.Sprintf("%0.2f", rating)
+		d      = &font.Drawer{Dst: img, Src: image.White, Face: face}
+		width  = height + d.MeasureString(text).Ceil() // star is as wide as ribbon is high
+		x      = r.Min.X + (r.Dx()-width)/2
+	)
+	draw.Draw(img, r, image.NewUniform(color.NRGBA{0, 0, 0, 160}), image.Point{}, draw.Over)
+	drawStar(img, float64(x)+float64(height)/2, float64(r.Min.Y)+float64(height)/2, float64(height)/2-2,
+		color.NRGBA{255, 193, 7, 255})
+	d.Dot = fixed.P(x+height, r.Min.Y+2+face.Ascent)
+	d.DrawString(text)
+}
+
+// draw a five-pointed star with outer radius radius centred on cx, cy.
+func drawStar(img *image.NRGBA, cx, cy, radius float64, c color.Color) {
+	var xs, ys [10]float64
+	for i := 0; i < 10; i++ {
+		r := radius
+		if i%2 == 1 {
+			r = radius * 0.45
+		}
+		a := -math.Pi/2 + float64(i)*math.Pi/5
+		xs[i], ys[i] = cx+r*math.Cos(a), cy+r*math.Sin(a)
+	}
+
+	// fill pixels whose centres are inside the polygon (even-odd rule)
+	for y := int(cy - radius); y <= int(cy+radius); y++ {
+		for x := int(cx - radius); x <= int(cx+radius); x++ {
+			px, py := float64(x)+0.5, float64(y)+0.5
+			inside := false
+			for i, j := 0, 9; i < 10; j, i = i, i+1 {
+				if (ys[i] > py) != (ys[j] > py) &&
+					px < (xs[j]-xs[i])*(py-ys[i])/(ys[j]-ys[i])+xs[i] {
+					inside = !inside
+				}
+			}
+			if inside {
+				img.Set(x, y, c)
+			}
+		}
+	}
+}
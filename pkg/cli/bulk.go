@@ -0,0 +1,230 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	aw "github.com/deanishe/awgo"
+	"github.com/pkg/errors"
+)
+
+// Books are selected for bulk actions by setting workflow variables of the
+// form book_<id> to "true", like shelves in runSelectShelves.
+const bookVarPrefix = "book_"
+
+// bulk actions
+const (
+	bulkCopy   = "copy"   // add selected books to other shelves
+	bulkMove   = "move"   // add to other shelves and remove from current shelf
+	bulkRemove = "remove" // remove selected books from current shelf
+)
+
+// returns true if book is selected.
+func bookSelected(id int64) bool {
+	return wf.Config.GetBool(fmt.Sprintf("%s%d", bookVarPrefix, id))
+}
+
+// IDs of selected books.
+func selectedBooks() []int64 {
+	var ids []int64
+	for k, v := range parseEnv() {
+		if !strings.HasPrefix(k, bookVarPrefix) || v != "true" {
+			continue
+		}
+		if id, err := strconv.ParseInt(strings.TrimPrefix(k, bookVarPrefix), 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// toggle selection of book opts.Query, or deselect all books if opts.Query is "none".
+func runSelectBook() {
+	wf.Configure(aw.TextErrors(true))
+	v := aw.NewArgVars()
+	if opts.Query == "none" {
+		for _, id := range selectedBooks() {
+			v.Var(fmt.Sprintf("%s%d", bookVarPrefix, id), "")
+		}
+		checkErr(v.Send())
+		return
+	}
+
+	var (
+		key   = bookVarPrefix + opts.Query
+		value = "true"
+	)
+	if wf.Config.GetBool(key) {
+		value = "false"
+	}
+	log.Printf("[bulk] book=%s, selected=%s", opts.Query, value)
+	checkErr(v.Var(key, value).Send())
+}
+
+// add items for bulk actions on selected books to shelf view.
+func addBulkActions(shelfTitle string) {
+	ids := selectedBooks()
+	if len(ids) == 0 {
+		return
+	}
+	n := fmt.Sprintf("%d Selected Books", len(ids))
+	if len(ids) == 1 {
+		n = "1 Selected Book"
+	}
+
+	for _, action := range []string{bulkMove, bulkCopy} {
+		title := "Move " + n + " to…"
+		if action == bulkCopy {
+			title = "Copy " + n + " to…"
+		}
+		wf.NewItem(title).
+			Subtitle("Choose shelves").
+			Arg("-noop").
+			UID("bulk-"+action).
+			Valid(true).
+			Icon(iconShelf).
+			Var("BULK_ACTION", action).
+			Var("action", "select").
+			Var("query", "").
+			Var("hide_alfred", "").
+			Var("passvars", "true")
+	}
+
+	wf.NewItem(fmt.Sprintf("Remove %s from “%s”", n, shelfTitle)).
+		Subtitle("Remove books from this shelf").
+		Arg("-bulk").
+		UID("bulk-remove").
+		Valid(true).
+		Icon(iconDelete).
+		Var("BULK_ACTION", bulkRemove).
+		Var("action", "shelf").
+		Var("query", opts.Query).
+		Var("hide_alfred", "").
+		Var("passvars", "true")
+
+	wf.NewItem("Clear Selection").
+		Subtitle(fmt.Sprintf("Deselect %s", n)).
+		Arg("-selectbook", "none").
+		UID("bulk-clear").
+		Valid(true).
+		Icon(iconReload).
+		Var("action", "shelf").
+		Var("query", opts.Query).
+		Var("hide_alfred", "").
+		Var("passvars", "true")
+}
+
+// copy, move or remove selected books. Shelves to copy or move books to are
+// in opts.Args.
+func runBulk() {
+	wf.Configure(aw.TextErrors(true))
+	if !opts.Authorised() {
+		return
+	}
+
+	var (
+		ids     = selectedBooks()
+		shelves = opts.Args
+		title   = opts.ShelfTitle
+		msg     string
+		err     error
+	)
+	if title == "" {
+		title = opts.ShelfName
+	}
+	log.Printf("[bulk] action=%q, shelf=%q, books=%v, shelves=%v", opts.BulkAction, opts.ShelfName, ids, shelves)
+
+	var (
+		changes []shelfChange
+		books   = countBooks(len(ids))
+	)
+	switch opts.BulkAction {
+	case bulkCopy:
+		changes = append(changes, addChange(books, ids, shelves))
+		msg = fmt.Sprintf("Copied %s to %s", books, countShelves(len(shelves)))
+	case bulkMove:
+		changes = append(changes, addChange(books, ids, shelves))
+		msg = fmt.Sprintf("Moved %s from “%s” to %s", books, title, countShelves(len(shelves)))
+		excl := exclusiveShelves()
+		switch {
+		case contains(shelves, opts.ShelfName):
+		// removing books from an exclusive shelf removes them from every
+		// shelf, but Goodreads moves them if they're added to another
+		// exclusive shelf
+		case excl[opts.ShelfName]:
+			if !anyExclusive(excl, shelves) {
+				msg = fmt.Sprintf("Copied %s to %s. Books can't be moved off “%s”", books, countShelves(len(shelves)), title)
+			}
+		default:
+			changes = append(changes, removeChanges(ids, opts.ShelfName)...)
+		}
+	case bulkRemove:
+		changes = removeChanges(ids, opts.ShelfName)
+		msg = fmt.Sprintf("Removed %s from “%s”", books, title)
+	default:
+		err = errors.Errorf("unknown bulk action %q", opts.BulkAction)
+	}
+	if err == nil && len(ids) > 0 {
+		var queued bool
+		queued, err = updateShelves(changes...)
+		msg += queuedNote(queued)
+	}
+	if err != nil {
+		notifyError("Bulk Action Failed", err)
+		log.Fatalf("[ERROR] bulk %s: %v", opts.BulkAction, err)
+	}
+
+	// reset selections
+	v := aw.NewArgVars().
+		Var("notification_title", "Shelves Updated").
+		Var("notification_text", msg).
+		Var("BULK_ACTION", "")
+	for _, id := range ids {
+		v.Var(fmt.Sprintf("%s%d", bookVarPrefix, id), "")
+	}
+	for _, s := range shelves {
+		v.Var("shelf_"+s, "")
+	}
+	checkErr(v.Send())
+}
+
+// changes removing books from shelf name. Goodreads has no bulk endpoint,
+// so books are removed one at a time.
+func removeChanges(ids []int64, name string) []shelfChange {
+	// titles for display
+	titles := map[int64]string{}
+	if store, ok := cachedShelf(name); ok {
+		shelf, err := store.Load(name)
+		logIfError(err, "load shelf %q: %v", name)
+		for _, b := range shelf.Books {
+			titles[b.ID] = b.Title
+		}
+	}
+
+	changes := make([]shelfChange, len(ids))
+	for i, id := range ids {
+		title := titles[id]
+		if title == "" {
+			title = fmt.Sprintf("Book %d", id)
+		}
+		changes[i] = removeChange(title, id, name)
+	}
+	return changes
+}
+
+// returns true if slice contains s.
+func contains(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
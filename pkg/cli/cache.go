@@ -5,12 +5,14 @@
 package cli
 
 import (
+	"fmt"
 	"io/ioutil"
 	"log"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,16 +32,22 @@ func init() {
 func runIcons() {
 	wf.Configure(aw.TextErrors(true))
 	icons := newIconCache(iconCacheDir)
-	if icons.HasQueue() {
-		checkErr(icons.ProcessQueue())
+	// keep going till script filters stop queuing covers
+	for {
+		checkErr(icons.loadQueue())
+		if !icons.HasQueue() {
+			break
+		}
+		logIfError(icons.ProcessQueue(), "cache icons: %v")
 	}
+	checkErr(evictCovers(iconCacheDir, opts.MaxCoverCache))
 }
 
 // Fetch RSS feeds and cache icons.
 func runFeeds() {
 	wf.Configure(aw.TextErrors(true))
 	// fetch RSS feeds
-	if !wf.Cache.Exists(shelvesKey) {
+	if !caches.Shelves.ListExists() {
 		log.Printf("[feeds] no shelves")
 		return
 	}
@@ -55,18 +63,27 @@ func runFeeds() {
 		err     error
 	)
 
-	checkErr(wf.Cache.LoadJSON(shelvesKey, &shelves))
+	shelves, err = caches.Shelves.List()
+	checkErr(err)
 	checkErr(wf.Cache.StoreJSON(feedsKey, time.Now()))
 
 	log.Println("[feeds] fetching RSS feeds...")
 	for _, s := range shelves {
 		var feed gr.Feed
-		if feed, err = api.FetchFeed(opts.UserID, s.Name); err == nil {
+		if feed, err = api.FetchFeed(opts.UserID, s.Name); err == gr.ErrNotModified {
+			// covers were queued when feed was last fetched
+			log.Printf("[feeds] feed %q unchanged", s.Name)
+			icons.AddFeedCovers(feed.Books...)
+			continue
+		}
+		if err == nil {
 			log.Printf("[feeds] %d book(s) in feed %q", len(feed.Books), s.Name)
+			icons.AddFeedCovers(feed.Books...)
 			icons.Add(feed.Books...)
 		}
 		logIfError(err, "fetch feed %q: %v", s.Name)
 	}
+	checkErr(icons.SaveFeedCovers())
 
 	if icons.HasQueue() {
 		log.Printf("[feeds] %d icon(s) queued for download", len(icons.Queue))
@@ -77,6 +94,132 @@ func runFeeds() {
 	}
 }
 
+// cacheType is a type of cached data the user can clear.
+type cacheType struct {
+	name  string // argument to -clearcache
+	title string
+	dir   string
+	clear func() error
+}
+
+// types of cached data shown in configuration.
+func cacheTypes() []cacheType {
+	return []cacheType{
+		{"covers", "Covers", iconCacheDir, clearDir(iconCacheDir)},
+		{"queries", "Searches", caches.Searches.Dir(), caches.Searches.Clear},
+		{"books", "Books", caches.Books.Dir(), caches.Books.Clear},
+		{"authors", "Authors", caches.Authors.Dir(), caches.Authors.Clear},
+		{"series", "Series", caches.Series.Dir(), caches.Series.Clear},
+		{"shelves", "Shelves", caches.Shelves.Dir(), caches.Shelves.Clear},
+	}
+}
+
+// returns function that deletes & re-creates a directory.
+func clearDir(dir string) func() error {
+	return func() error {
+		if err := os.RemoveAll(dir); err != nil {
+			return errors.Wrap(err, "clear cache")
+		}
+		util.MustExist(dir)
+		return nil
+	}
+}
+
+// returns number and total size of cached files in dir.
+func cacheUsage(dir string) (n int, size int64) {
+	_ = filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if x := filepath.Ext(p); !fi.IsDir() && (x == ".json" || x == ".png" || x == ".jpg") {
+			n++
+			size += fi.Size()
+		}
+		return nil
+	})
+	return
+}
+
+// format a number of bytes for humans.
+func formatSize(n int64) string {
+	size := float64(n)
+	for _, unit := range []string{"B", "KB", "MB"} {
+		if size < 1000 {
+			if unit == "B" {
+				return fmt.Sprintf("%d %s", n, unit)
+			}
+			return fmt.Sprintf("%.1f %s", size, unit)
+		}
+		size /= 1000
+	}
+	return fmt.Sprintf("%.1f GB", size)
+}
+
+// delete cached data of type opts.Query, or all data if opts.Query is "all".
+func runClearCache() {
+	wf.Configure(aw.TextErrors(true))
+
+	var (
+		types = cacheTypes()
+		title = "All cached data deleted"
+		err   error
+	)
+	if opts.Query == "all" {
+		for _, ct := range types {
+			if err = ct.clear(); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = clearDir(httpCacheDir)()
+		}
+		if err == nil {
+			err = wf.Cache.Store(libraryKey, nil)
+		}
+	} else {
+		err = errors.Errorf("unknown cache %q", opts.Query)
+		for _, ct := range types {
+			if ct.name == opts.Query {
+				title = fmt.Sprintf("Cached %s deleted", strings.ToLower(ct.title))
+				err = ct.clear()
+				break
+			}
+		}
+	}
+	if err != nil {
+		notifyError("Clear Cache Failed", err, "config")
+		log.Fatalf("[ERROR] clear cache %q: %v", opts.Query, err)
+	}
+
+	log.Printf("[cache] cleared %q", opts.Query)
+	checkErr(notify("Cache Cleared", title, "config"))
+}
+
+// delete cached covers and queue covers of all books in library for download.
+func runRebuildCovers() {
+	wf.Configure(aw.TextErrors(true))
+	checkErr(clearDir(iconCacheDir)())
+
+	lib, err := loadLibrary()
+	checkErr(err)
+	_, err = lib.Update()
+	checkErr(err)
+	checkErr(lib.Save())
+
+	icons := newIconCache(iconCacheDir)
+	for _, e := range lib.Books {
+		icons.Add(e.Book)
+	}
+	n := len(icons.Queue)
+	if icons.HasQueue() {
+		checkErr(icons.Close())
+		checkErr(runJob(iconsJob, "-icons"))
+	}
+
+	log.Printf("[cache] %d cover(s) queued for download", n)
+	checkErr(notify("Rebuilding Covers", fmt.Sprintf("Downloading %d cover(s)", n), "config"))
+}
+
 // Check for workflow update + clear stale cache files.
 func runHousekeeping() {
 	wf.Configure(aw.TextErrors(true))
@@ -85,7 +228,7 @@ func runHousekeeping() {
 	// time.Sleep(15)
 
 	wg := sync.WaitGroup{}
-	wg.Add(3)
+	wg.Add(4)
 
 	// check for update
 	go func() {
@@ -107,13 +250,21 @@ func runHousekeeping() {
 			},
 		}
 		logIfError(dc.Clean(), "[housekeeping] clean icon cache: %v")
+		logIfError(evictCovers(iconCacheDir, opts.MaxCoverCache), "[housekeeping] evict covers: %v")
+	}()
+
+	// clean shelves cache
+	go func() {
+		defer wg.Done()
+		log.Println("[housekeeping] cleaning shelves cache...")
+		logIfError(cleanShelves(), "[housekeeping] clean shelves cache: %v")
 	}()
 
 	// clean other caches
 	go func() {
 		defer wg.Done()
-		dirs := []string{authorsCacheDir, booksCacheDir, searchCacheDir}
-		ages := []time.Duration{opts.MaxCache.Default, opts.MaxCache.Default, opts.MaxCache.Search}
+		dirs := []string{caches.Authors.Dir(), caches.Books.Dir(), httpCacheDir, caches.Searches.Dir(), caches.Series.Dir()}
+		ages := []time.Duration{opts.MaxCache.Default, opts.MaxCache.Default, opts.MaxCache.Default, opts.MaxCache.Search, opts.MaxCache.Default}
 		for i, dir := range dirs {
 			i := i
 			log.Printf("[housekeeping] cleaning %s cache...", filepath.Base(dir))
@@ -121,13 +272,97 @@ func runHousekeeping() {
 				root:   dir,
 				maxAge: func() time.Duration { return ages[i] },
 			}
-			logIfError(dc.Clean(), "[housekeeping] clean query cache: %v")
+			logIfError(dc.Clean(), "[housekeeping] clean %s cache: %v", filepath.Base(dir))
 		}
 	}()
 
 	wg.Wait()
 }
 
+// delete least-recently used covers until cover cache is no larger than maxMB.
+// Covers' access times are updated when they're shown.
+func evictCovers(dir string, maxMB int) error {
+	if maxMB <= 0 {
+		return nil
+	}
+
+	type cover struct {
+		path string
+		info os.FileInfo
+	}
+	var (
+		covers  []cover
+		size    int64
+		maxSize = int64(maxMB) * 1000 * 1000
+	)
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if x := filepath.Ext(p); !fi.IsDir() && (x == ".png" || x == ".jpg") {
+			covers = append(covers, cover{p, fi})
+			size += fi.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "read cover cache")
+	}
+	if size <= maxSize {
+		return nil
+	}
+
+	sort.Slice(covers, func(i, j int) bool { return accessTime(covers[i].info).Before(accessTime(covers[j].info)) })
+	var n int
+	for _, c := range covers {
+		if size <= maxSize {
+			break
+		}
+		if err := os.Remove(c.path); err != nil {
+			return errors.Wrap(err, util.PrettyPath(c.path))
+		}
+		size -= c.info.Size()
+		n++
+	}
+	log.Printf("[covers] evicted %d cover(s), cache size %s", n, formatSize(size))
+	return nil
+}
+
+// delete cached shelves that are no longer in the user's list of shelves.
+func cleanShelves() error {
+	if !caches.Shelves.ListExists() {
+		return nil
+	}
+	shelves, err := caches.Shelves.List()
+	if err != nil {
+		return err
+	}
+
+	current := map[string]bool{}
+	for _, s := range shelves {
+		current[s.Name] = true
+	}
+	for _, order := range gr.ShelfSorts {
+		store := caches.Shelves.Sorted(order)
+		if !util.PathExists(store.Dir()) {
+			continue
+		}
+		names, err := store.Names()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if !current[name] {
+				log.Printf("[housekeeping] deleting shelf %q (%s) ...", name, order)
+				if err := store.Delete(name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
 type cacheDir struct {
 	info os.FileInfo
 	path string
@@ -193,9 +428,9 @@ func (dc *dirCleaner) cleanFiles() error {
 			dc.addDir(fi, p)
 			return nil
 		}
-		// delete cached queries (.json) and covers (.png).
+		// delete cached queries (.json) and covers (.png & .jpg).
 		x := filepath.Ext(fi.Name())
-		if x != ".json" && x != ".png" {
+		if x != ".json" && x != ".png" && x != ".jpg" {
 			return nil
 		}
 
@@ -5,7 +5,6 @@
 package cli
 
 import (
-	"crypto/sha256"
 	"flag"
 	"fmt"
 	"log"
@@ -21,6 +20,7 @@ import (
 	"github.com/deanishe/awgo/util"
 	"github.com/pkg/errors"
 
+	"go.deanishe.net/alfred-booksearch/pkg/cache"
 	"go.deanishe.net/alfred-booksearch/pkg/gr"
 )
 
@@ -52,6 +52,8 @@ const (
 	userJob    = "user"
 	seriesJob  = "series"
 	bookJob    = "book"
+	libraryJob = "library"
+	replayJob  = "replay"
 
 	tokensKey = "oauth_tokens"
 
@@ -61,19 +63,16 @@ const (
 
 var (
 	// cache directories
-	authorsCacheDir string
-	booksCacheDir   string
-	iconCacheDir    string
-	searchCacheDir  string
-	seriesCacheDir  string
-	shelvesCacheDir string
+	httpCacheDir string
+	iconCacheDir string
 
 	scriptsDir     string
 	userScriptsDir string
 
-	api   *gr.Client
-	store *keychainStore
-	wf    *aw.Workflow
+	api    *gr.Client
+	caches *cache.Cache
+	store  *keychainStore
+	wf     *aw.Workflow
 )
 
 func init() {
@@ -83,12 +82,9 @@ func init() {
 		aw.HelpURL(issueTrackerURL),
 		update.GitHub(repo),
 	)
-	authorsCacheDir = filepath.Join(wf.CacheDir(), "authors")
-	booksCacheDir = filepath.Join(wf.CacheDir(), "books")
+	httpCacheDir = filepath.Join(wf.CacheDir(), "http")
 	iconCacheDir = filepath.Join(wf.CacheDir(), "covers")
-	searchCacheDir = filepath.Join(wf.CacheDir(), "queries")
-	shelvesCacheDir = filepath.Join(wf.CacheDir(), "shelves")
-	seriesCacheDir = filepath.Join(wf.CacheDir(), "series")
+	apiJobs = newAPIScheduler()
 
 	scriptsDir = "scripts"
 	userScriptsDir = filepath.Join(wf.DataDir(), "scripts")
@@ -96,6 +92,8 @@ func init() {
 	navActions = []navAction{
 		{"Search", "Search for books", "search", iconBook},
 		{"Shelves", "List bookshelves", "shelves", iconShelf},
+		{"Library", "Search books you've already seen", "library", iconBook},
+		{"Pinned", "Pinned books and authors", "pinned", iconSave},
 		{"Configuration", "Workflow configuration", "config", iconConfig},
 	}
 }
@@ -155,6 +153,7 @@ func Run() {
 
 func run() {
 	checkErr(opts.Prepare(wf.Args()))
+	defer apiJobDone()
 
 	if opts.FlagNoop {
 		return
@@ -208,6 +207,21 @@ func run() {
 		return
 	}
 
+	if opts.FlagClearCache {
+		runClearCache()
+		return
+	}
+
+	if opts.FlagRebuildCovers {
+		runRebuildCovers()
+		return
+	}
+
+	if opts.FlagClearHistory {
+		runClearHistory()
+		return
+	}
+
 	if opts.FlagConf {
 		runConfig()
 		return
@@ -218,11 +232,31 @@ func run() {
 		return
 	}
 
+	if opts.FlagPinned {
+		runPinned()
+		return
+	}
+
+	if opts.FlagPin || opts.FlagUnpin || opts.FlagPinAuthor || opts.FlagUnpinAuthor {
+		runPin()
+		return
+	}
+
 	if opts.FlagShelves {
 		runShelves()
 		return
 	}
 
+	if opts.FlagSelectBook {
+		runSelectBook()
+		return
+	}
+
+	if opts.FlagBulk {
+		runBulk()
+		return
+	}
+
 	if opts.FlagShelf {
 		runShelf()
 		return
@@ -263,6 +297,31 @@ func run() {
 		return
 	}
 
+	if opts.FlagReplay {
+		runReplay()
+		return
+	}
+
+	if opts.FlagRetry {
+		runRetry()
+		return
+	}
+
+	if opts.FlagDiscard {
+		runDiscard()
+		return
+	}
+
+	if opts.FlagUndo {
+		runUndo()
+		return
+	}
+
+	if opts.FlagFinish || opts.FlagStart {
+		runReadingDates()
+		return
+	}
+
 	if opts.FlagSeries {
 		runSeries()
 		return
@@ -303,6 +362,16 @@ func run() {
 		return
 	}
 
+	if opts.FlagLibrary {
+		runLibrary()
+		return
+	}
+
+	if opts.FlagCacheLibrary {
+		runCacheLibrary()
+		return
+	}
+
 	var runVars bool
 	fs.Visit(func(f *flag.Flag) {
 		if f.Name == "action" || f.Name == "hide" || f.Name == "passvars" || f.Name == "notify" || f.Name == "query" {
@@ -317,36 +386,45 @@ func run() {
 
 // Create cache directories & fetch essential data.
 func bootstrap() error {
-	util.MustExist(authorsCacheDir)
-	util.MustExist(booksCacheDir)
 	util.MustExist(iconCacheDir)
-	util.MustExist(searchCacheDir)
-	util.MustExist(seriesCacheDir)
-	util.MustExist(shelvesCacheDir)
 	util.MustExist(userScriptsDir)
 
+	var err error
+	if caches, err = cache.Open(wf.CacheDir()); err != nil {
+		return errors.Wrap(err, "open cache")
+	}
+
 	store = &keychainStore{
 		name:   wf.BundleID(),
 		token:  opts.AccessToken,
 		secret: opts.AccessSecret,
 	}
 
-	var err error
 	if api, err = gr.New(apiKey, apiSecret, store); err != nil {
 		return errors.Wrap(err, "create API client")
 	}
 	api.Log = logger{}
+	if api.Cache, err = gr.NewDirCache(httpCacheDir); err != nil {
+		return errors.Wrap(err, "create HTTP cache")
+	}
+	// share rate limit with other instances & background jobs
+	api.Limiter = gr.NewFileLimiter(filepath.Join(wf.CacheDir(), "last_request"), time.Second)
 
 	if !opts.Authorised() {
 		return nil
 	}
 
+	// send shelf changes queued while Goodreads was unreachable
+	if err := startReplay(); err != nil {
+		return err
+	}
+
 	// fetch user ID & name if not already set
 	if opts.UserID == 0 {
 		if err := runJob(userJob, "-userinfo"); err != nil {
 			return err
 		}
-	} else if wf.Cache.Exists(shelvesKey) {
+	} else if caches.Shelves.ListExists() {
 		if !wf.IsRunning(feedsJob) {
 			var t time.Time
 			if wf.Cache.Exists(feedsKey) {
@@ -361,7 +439,7 @@ func bootstrap() error {
 				}
 			}
 		}
-	} else if err := runJob(shelvesJob, "-saveshelves"); err != nil {
+	} else if err := runAPIJob(shelvesJob, "-saveshelves"); err != nil {
 		return err
 	}
 
@@ -429,10 +507,6 @@ func addNavActions(ignore ...string) {
 	}
 }
 
-func hash(s string) string {
-	return fmt.Sprintf("%x", sha256.Sum256([]byte(s)))
-}
-
 func notify(title, msg string, action ...string) error {
 	v := aw.NewArgVars().
 		Var("notification_title", title).
@@ -455,6 +529,7 @@ func notifyIfError(err error, title string, fatal bool) {
 		return
 	}
 	_ = notify("💀 "+title+" 💀", err.Error())
+	apiJobDone()
 	log.Fatalf("[ERROR] %s: %v", title, err)
 }
 
@@ -88,6 +88,50 @@ func runConfig() {
 			Var("hide_alfred", "")
 	}
 
+	addQueueItems()
+	addUndoItem("config")
+
+	var (
+		total int
+		size  int64
+	)
+	for _, ct := range cacheTypes() {
+		n, sz := cacheUsage(ct.dir)
+		total += n
+		size += sz
+		wf.NewItem("Clear Cached "+ct.title).
+			Subtitle(fmt.Sprintf("%d file(s), %s · ↩ to delete", n, formatSize(sz))).
+			Arg("-clearcache", ct.name).
+			Valid(true).
+			Icon(iconDelete).
+			Var("action", "config").
+			Var("hide_alfred", "")
+	}
+
+	wf.NewItem("Clear All Caches").
+		Subtitle(fmt.Sprintf("%d file(s), %s · ↩ to delete", total, formatSize(size))).
+		Arg("-clearcache", "all").
+		Valid(true).
+		Icon(iconDelete).
+		Var("action", "config").
+		Var("hide_alfred", "")
+
+	wf.NewItem("Clear History").
+		Subtitle("Delete recent searches and books").
+		Arg("-clearhistory").
+		Valid(true).
+		Icon(iconDelete).
+		Var("action", "config").
+		Var("hide_alfred", "")
+
+	wf.NewItem("Rebuild Covers").
+		Subtitle("Delete and re-download covers of all books in your library").
+		Arg("-rebuildcovers").
+		Valid(true).
+		Icon(iconReload).
+		Var("action", "config").
+		Var("hide_alfred", "")
+
 	wf.NewItem("Open Scripts Folder").
 		Subtitle("Open custom scripts folder").
 		Arg("-open", userScriptsDir).
@@ -0,0 +1,197 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	"github.com/natefinch/atomic"
+	"github.com/pkg/errors"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+const (
+	// Open Library cover by ISBN. default=false makes missing covers a 404.
+	openLibraryCoverURL = "https://covers.openlibrary.org/b/isbn/%s-M.jpg?default=false"
+
+	// size of generated placeholder covers
+	placeholderSize   = 150
+	placeholderMargin = 10
+)
+
+// returns cover URLs for book in order of preference: API, RSS feed, Open Library.
+// URLs that have recently failed are omitted.
+func (c *iconCache) coverURLs(b gr.Book) []string {
+	var (
+		urls []string
+		seen = map[string]bool{}
+	)
+	add := func(URL string) {
+		if URL != "" && !seen[URL] && !c.failed(URL) {
+			urls = append(urls, URL)
+		}
+		seen[URL] = true
+	}
+
+	if b.HasCover() {
+		add(b.ImageURL)
+	}
+	add(c.feedCovers[b.ID])
+	for _, isbn := range []string{b.ISBN13, b.ISBN} {
+		if isbn != "" {
+			add(fmt.Sprintf(openLibraryCoverURL, isbn))
+		}
+	}
+	return urls
+}
+
+// AddFeedCovers remembers the covers of books from an RSS feed. Feeds contain
+// covers the API doesn't, so they are a fallback for other views of the books.
+func (c *iconCache) AddFeedCovers(books ...gr.Book) {
+	for _, b := range books {
+		if b.HasCover() {
+			c.feedCovers[b.ID] = b.ImageURL
+		}
+	}
+}
+
+func (c *iconCache) loadFeedCovers() error {
+	data, err := ioutil.ReadFile(c.feedCoversFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "read feed covers")
+	}
+	if err := json.Unmarshal(data, &c.feedCovers); err != nil {
+		c.feedCovers = map[int64]string{}
+		return errors.Wrap(err, "decode feed covers")
+	}
+	return nil
+}
+
+// SaveFeedCovers saves covers added with AddFeedCovers.
+func (c *iconCache) SaveFeedCovers() error {
+	data, err := json.Marshal(c.feedCovers)
+	if err != nil {
+		return errors.Wrap(err, "encode feed covers")
+	}
+	if err := atomic.WriteFile(c.feedCoversFile, bytes.NewReader(data)); err != nil {
+		return errors.Wrap(err, "write feed covers")
+	}
+	return nil
+}
+
+// placeholderCover renders a cover showing title and author on a background
+// colour derived from the book's ID.
+func placeholderCover(icon cacheIcon) image.Image {
+	var (
+		face     = basicfont.Face7x13
+		img      = imaging.New(placeholderSize, placeholderSize, coverColour(icon.ID))
+		width    = placeholderSize - 2*placeholderMargin
+		maxChars = width / face.Advance
+		title    = wrapText(icon.Title, maxChars, 5)
+		author   = wrapText(icon.Author, maxChars, 2)
+		height   = face.Height * (len(title) + 1 + len(author))
+		y        = (placeholderSize-height)/2 + face.Ascent
+	)
+
+	draw := func(lines []string, c color.Color) {
+		d := &font.Drawer{Dst: img, Src: image.NewUniform(c), Face: face}
+		for _, s := range lines {
+			x := (placeholderSize - d.MeasureString(s).Ceil()) / 2
+			d.Dot = fixed.P(x, y)
+			d.DrawString(s)
+			y += face.Height
+		}
+	}
+	draw(title, color.White)
+	y += face.Height
+	draw(author, color.NRGBA{255, 255, 255, 180})
+	return img
+}
+
+// word-wrap s to lines of at most width characters. If there are more than
+// max lines, the last is truncated with an ellipsis.
+func wrapText(s string, width, max int) []string {
+	var (
+		lines []string
+		line  []rune
+	)
+	for _, word := range strings.Fields(s) {
+		w := []rune(word)
+		if len(line) > 0 && len(line)+1+len(w) > width {
+			lines = append(lines, string(line))
+			line = nil
+		}
+		if len(line) > 0 {
+			line = append(line, ' ')
+		}
+		line = append(line, w...)
+		// break words longer than a line
+		for len(line) > width {
+			lines = append(lines, string(line[:width]))
+			line = line[width:]
+		}
+	}
+	if len(line) > 0 {
+		lines = append(lines, string(line))
+	}
+
+	if len(lines) > max {
+		lines = lines[:max]
+		last := []rune(lines[max-1])
+		if len(last) > width-3 {
+			last = last[:width-3]
+		}
+		lines[max-1] = strings.TrimSpace(string(last)) + "..."
+	}
+	return lines
+}
+
+// returns a muted colour for a book ID. Hues are spaced by the golden angle,
+// so books with consecutive IDs get clearly different colours.
+func coverColour(id int64) color.Color {
+	h := math.Mod(float64(id)*137.508, 360)
+	return hsvColour(h, 0.45, 0.55)
+}
+
+// convert HSV (h in degrees, s & v 0-1) to RGB.
+func hsvColour(h, s, v float64) color.Color {
+	var (
+		c = v * s
+		x = c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+		m = v - c
+
+		r, g, b float64
+	)
+	switch {
+	case h < 60:
+		r, g, b = c, x, 0
+	case h < 120:
+		r, g, b = x, c, 0
+	case h < 180:
+		r, g, b = 0, c, x
+	case h < 240:
+		r, g, b = 0, x, c
+	case h < 300:
+		r, g, b = x, 0, c
+	default:
+		r, g, b = c, 0, x
+	}
+	return color.NRGBA{uint8((r + m) * 255), uint8((g + m) * 255), uint8((b + m) * 255), 255}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	aw "github.com/deanishe/awgo"
+	"github.com/fxtlabs/date"
+	"github.com/pkg/errors"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+const (
+	// history is user data, so it's kept in the data directory,
+	// not the cache, which may be cleared.
+	historyKey = "history.json"
+	// how many searches & books to remember
+	maxHistory = 20
+)
+
+// history is the user's recent searches and actioned books, newest first.
+type history struct {
+	Searches []historySearch
+	Books    []historyBook
+}
+
+// historySearch is a search query.
+type historySearch struct {
+	Query string
+	Time  time.Time
+}
+
+// historyBook is a book a script was run on.
+type historyBook struct {
+	Book gr.Book
+	Time time.Time
+}
+
+// load history from data directory.
+func loadHistory() (*history, error) {
+	h := &history{}
+	if !wf.Data.Exists(historyKey) {
+		return h, nil
+	}
+	if err := wf.Data.LoadJSON(historyKey, h); err != nil {
+		return nil, errors.Wrap(err, "load history")
+	}
+	return h, nil
+}
+
+// Save history to data directory.
+func (h *history) Save() error {
+	return wf.Data.StoreJSON(historyKey, h)
+}
+
+// AddSearch moves query to the top of search history.
+func (h *history) AddSearch(query string) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return
+	}
+	searches := []historySearch{{Query: query, Time: time.Now()}}
+	for _, s := range h.Searches {
+		if !strings.EqualFold(s.Query, query) && len(searches) < maxHistory {
+			searches = append(searches, s)
+		}
+	}
+	h.Searches = searches
+}
+
+// AddBook moves book to the top of book history.
+func (h *history) AddBook(b gr.Book) {
+	if b.ID == 0 {
+		return
+	}
+	books := []historyBook{{Book: b, Time: time.Now()}}
+	for _, hb := range h.Books {
+		if hb.Book.ID != b.ID && len(books) < maxHistory {
+			books = append(books, hb)
+		}
+	}
+	h.Books = books
+}
+
+// record book a script is run on and, if it was found by a search,
+// the search query. Only actioned searches are recorded, not every
+// query typed into Alfred.
+func recordHistory(b gr.Book) error {
+	h, err := loadHistory()
+	if err != nil {
+		return err
+	}
+	if wf.Config.Get("last_action") == "search" {
+		h.AddSearch(wf.Config.Get("last_query"))
+	}
+	h.AddBook(b)
+	return h.Save()
+}
+
+// book from the variables set by bookItem.
+func bookFromEnv() gr.Book {
+	b := gr.Book{
+		ID:       opts.BookID,
+		Title:    opts.BookTitle,
+		Author:   gr.Author{ID: opts.AuthorID, Name: opts.AuthorName, URL: wf.Config.Get("AUTHOR_URL")},
+		Series:   gr.Series{ID: opts.SeriesID, Title: opts.SeriesName},
+		ISBN:     wf.Config.Get("ISBN"),
+		Rating:   wf.Config.GetFloat("RATING"),
+		URL:      wf.Config.Get("BOOK_URL"),
+		ImageURL: wf.Config.Get("IMAGE_URL"),
+	}
+	if y := wf.Config.GetInt("YEAR"); y > 0 {
+		b.PubDate, b.PubDatePrecision = date.New(y, time.January, 1), gr.PrecisionYear
+	}
+	return b
+}
+
+// show recent searches and books.
+func showHistory() {
+	h, err := loadHistory()
+	checkErr(err)
+
+	wf.Configure(aw.SuppressUIDs(true))
+
+	for _, s := range h.Searches {
+		wf.NewItem(s.Query).
+			Subtitle("Recent search · " + s.Time.Format("2 Jan 2006 15:04")).
+			Autocomplete(s.Query).
+			Icon(iconReload)
+	}
+
+	var (
+		icons = newIconCache(iconCacheDir)
+		mods  = LoadModifiers()
+	)
+	for _, hb := range h.Books {
+		bookItem(hb.Book, icons, mods)
+	}
+
+	wf.WarnEmpty("Search for Books", "Enter a title, author or ISBN")
+
+	if icons.HasQueue() {
+		var err error
+		if err = icons.Close(); err == nil {
+			err = runJob(iconsJob, "-icons")
+		}
+		logIfError(err, "cache icons: %v")
+	}
+
+	if wf.IsRunning(iconsJob) {
+		wf.Rerun(rerunInterval)
+	}
+	wf.SendFeedback()
+}
+
+// delete search & book history.
+func runClearHistory() {
+	wf.Configure(aw.TextErrors(true))
+
+	if err := wf.Data.Store(historyKey, nil); err != nil {
+		notifyError("Clear History Failed", err, "config")
+		log.Fatalf("[ERROR] clear history: %v", err)
+	}
+
+	log.Print("[history] cleared")
+	checkErr(notify("History Cleared", "Recent searches and books deleted", "config"))
+}
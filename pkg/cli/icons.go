@@ -6,17 +6,21 @@ package cli
 import (
 	"bytes"
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"image"
+	"image/jpeg"
 	"image/png"
+	"io"
+	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
-	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	aw "github.com/deanishe/awgo"
@@ -29,6 +33,7 @@ import (
 
 // Workflow icons
 var (
+	iconAuthor          = &aw.Icon{Value: "icons/author.png"}
 	iconBook            = &aw.Icon{Value: "icons/book.png"}
 	iconConfig          = &aw.Icon{Value: "icons/config.png"}
 	iconDelete          = &aw.Icon{Value: "icons/delete.png"}
@@ -47,7 +52,6 @@ var (
 	iconUpdateAvailable = &aw.Icon{Value: "icons/update-available.png"}
 	iconUpdateOK        = &aw.Icon{Value: "icons/update-ok.png"}
 	iconWarning         = &aw.Icon{Value: "icons/warning.png"}
-	// iconAuthor          = &aw.Icon{Value: "icons/author.png"}
 	// iconLink            = &aw.Icon{Value: "icons/link.png"}
 	// iconURL             = &aw.Icon{Value: "icons/url.png"}
 	// iconDefault         = &aw.Icon{Value: "icon.png"}
@@ -79,17 +83,45 @@ func init() {
 	userAgent = "Alfred Booksearch Workflow " + version + " (+https://github.com/deanishe/alfred-booksearch)"
 }
 
+const (
+	// size of square cover icons
+	coverIconSize = 128
+	// JPEG quality of large covers
+	coverJPEGQuality = 90
+	// how often to update access time of a cover when it's shown
+	coverTouchInterval = 24 * time.Hour
+	// delay before retrying a failed cover download. Doubles with each failure.
+	coverRetryDelay = time.Hour
+	// maximum delay before retrying a failed cover download
+	coverMaxRetryDelay = 7 * 24 * time.Hour
+)
+
 type cacheIcon struct {
-	ID   int64
-	URL  string
-	Path string
+	ID     int64
+	Title  string
+	Author string
+	URLs   []string // cover sources in order of preference
+	Path   string
+}
+
+// coverFailure is a cover URL that could not be downloaded.
+type coverFailure struct {
+	Failures   int
+	RetryAfter time.Time
 }
 
 type iconCache struct {
-	Dir       string
-	Queue     []cacheIcon
-	queueFile string
-	seen      map[int64]bool
+	Dir          string
+	Queue        []cacheIcon
+	queueFile    string
+	seen         map[int64]bool
+	failures     map[string]coverFailure // keyed by URL
+	failuresFile string
+
+	feedCovers     map[int64]string // cover URLs from RSS feeds
+	feedCoversFile string
+
+	badges bool // whether to add shelf & rating badges to covers
 }
 
 func newIconCache(dir string) *iconCache {
@@ -99,106 +131,252 @@ func newIconCache(dir string) *iconCache {
 		Queue:     []cacheIcon{},
 		queueFile: filepath.Join(dir, "queue.txt"),
 		seen:      map[int64]bool{},
+
+		failures:     map[string]coverFailure{},
+		failuresFile: filepath.Join(dir, "failures.json"),
+
+		feedCovers:     map[int64]string{},
+		feedCoversFile: filepath.Join(dir, "feeds.json"),
+
+		badges: opts.CoverBadges,
 	}
-	if err := icons.loadQueue(); err != nil {
-		panic(err)
-	}
+	// these are only optimisations, so start afresh if they can't be read
+	logIfError(icons.loadFailures(), "load cover failures: %v")
+	logIfError(icons.loadFeedCovers(), "load feed covers: %v")
 
 	return icons
 }
 
-// Add URLs to queue.
+// Add queues books' covers for retrieval. Books are queued if they have no
+// cover yet, or only a placeholder and another source to try.
 func (c *iconCache) Add(books ...gr.Book) {
 	for _, b := range books {
-		// ignore PNGs, as they're placeholders (real covers are JPG)
-		if filepath.Ext(b.ImageURL) == ".png" {
+		if c.seen[b.ID] {
 			continue
 		}
-		if !c.seen[b.ID] {
-			if !c.Exists(b) {
-				// log.Printf("[icons] queuing for cover retrieval: %s", b)
-				c.Queue = append(c.Queue, cacheIcon{
-					ID:  b.ID,
-					URL: b.ImageURL,
-				})
-			}
-			c.seen[b.ID] = true
+		c.seen[b.ID] = true
+		if c.Exists(b) {
+			continue
 		}
+		urls := c.coverURLs(b)
+		if len(urls) == 0 && util.PathExists(c.placeholderFile(b.ID)) {
+			continue
+		}
+		title := b.TitleNoSeries
+		if title == "" {
+			title = b.Title
+		}
+		c.Queue = append(c.Queue, cacheIcon{
+			ID:     b.ID,
+			Title:  title,
+			Author: b.Author.Name,
+			URLs:   urls,
+		})
 	}
 }
 
 // BookIcon returns icon for a Book.
 func (c *iconCache) BookIcon(b gr.Book) *aw.Icon {
 	p := c.cachefile(b.ID)
-	if util.PathExists(p) {
-		return &aw.Icon{Value: p}
+	if touchCover(p) {
+		touchCover(c.largeFile(b.ID))
+	} else {
+		// Queue icon for caching
+		c.Add(b)
+		if p = c.placeholderFile(b.ID); !touchCover(p) {
+			return iconBook
+		}
+	}
+	if c.badges {
+		badged, err := c.badgedCover(p, b)
+		if err != nil {
+			log.Printf("[icons] badge cover %q: %v", p, err)
+			return &aw.Icon{Value: p}
+		}
+		p = badged
 	}
-	// Assume that any PNG is a placeholder (actual covers are JPEGs)
-	if filepath.Ext(b.ImageURL) == ".png" {
-		return iconBook
+	return &aw.Icon{Value: p}
+}
+
+// returns true if cover file exists. Its access time is updated to mark
+// it as recently used, so it isn't evicted. The modification time is left
+// alone, so the cover is still re-downloaded when it gets old.
+func touchCover(path string) bool {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	if time.Since(accessTime(fi)) > coverTouchInterval {
+		logIfError(os.Chtimes(path, time.Now(), fi.ModTime()), "touch cover: %v")
 	}
-	// Queue icon for caching
-	c.Add(b)
-	return iconBook
+	return true
 }
 
-// Exists returns true if book's icon is already cached.
+// Exists returns true if book's cover is already cached.
 func (c *iconCache) Exists(b gr.Book) bool {
-	return util.PathExists(c.cachefile(b.ID))
+	return util.PathExists(c.cachefile(b.ID)) && util.PathExists(c.largeFile(b.ID))
 }
 
-// cachefile returns path of cache file for URL/name s.
+// cachefile returns path of square icon-sized cover for book ID.
 func (c *iconCache) cachefile(id int64) string {
 	return filepath.Join(c.Dir, cachefileID(id, "png"))
 }
 
+// largeFile returns path of full-size cover for book ID.
+func (c *iconCache) largeFile(id int64) string {
+	return filepath.Join(c.Dir, cachefileID(id, "jpg"))
+}
+
+// coverPath returns path of the largest cached cover for book ID,
+// or an empty string if no cover is cached.
+func coverPath(id int64) string {
+	for _, x := range []string{"jpg", "png"} {
+		p := filepath.Join(iconCacheDir, cachefileID(id, x))
+		if util.PathExists(p) {
+			return p
+		}
+	}
+	return ""
+}
+
+// placeholderFile returns path of generated cover file for book ID.
+func (c *iconCache) placeholderFile(id int64) string {
+	return filepath.Join(c.Dir, cachefileID(id, "placeholder.png"))
+}
+
+// returns true if downloading URL has failed and shouldn't be retried yet.
+func (c *iconCache) failed(URL string) bool {
+	f, ok := c.failures[URL]
+	return ok && time.Now().Before(f.RetryAfter)
+}
+
+// record a failed download of URL. The retry delay doubles with each failure.
+func (c *iconCache) addFailure(URL string) {
+	f := c.failures[URL]
+	f.Failures++
+	delay := coverMaxRetryDelay
+	if f.Failures < 10 {
+		delay = coverRetryDelay << uint(f.Failures-1)
+	}
+	if delay > coverMaxRetryDelay {
+		delay = coverMaxRetryDelay
+	}
+	f.RetryAfter = time.Now().Add(delay)
+	c.failures[URL] = f
+	log.Printf("[icons] failure #%d for %q, retry after %v", f.Failures, URL, delay)
+}
+
+func (c *iconCache) loadFailures() error {
+	data, err := ioutil.ReadFile(c.failuresFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "read failures")
+	}
+	if err := json.Unmarshal(data, &c.failures); err != nil {
+		c.failures = map[string]coverFailure{}
+		return errors.Wrap(err, "decode failures")
+	}
+	return nil
+}
+
+// save failures, forgetting those that haven't recurred for a long time.
+func (c *iconCache) saveFailures() error {
+	cutoff := time.Now().Add(-coverMaxRetryDelay)
+	for URL, f := range c.failures {
+		if f.RetryAfter.Before(cutoff) {
+			delete(c.failures, URL)
+		}
+	}
+	data, err := json.Marshal(c.failures)
+	if err != nil {
+		return errors.Wrap(err, "encode failures")
+	}
+	if err := atomic.WriteFile(c.failuresFile, bytes.NewReader(data)); err != nil {
+		return errors.Wrap(err, "write failures")
+	}
+	return nil
+}
+
 // HasQueue returns true if there are Queued files.
 func (c *iconCache) HasQueue() bool { return len(c.Queue) > 0 }
 
+// open queue file and acquire an exclusive lock on it. The lock is
+// released when the file is closed. The queue file must never be replaced,
+// or processes would lock different files.
+func (c *iconCache) lockQueue(flag int) (*os.File, error) {
+	f, err := os.OpenFile(c.queueFile, flag|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "open queue")
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, errors.Wrap(err, "lock queue")
+	}
+	return f, nil
+}
+
+// loadQueue moves all queued icons from the queue file to Queue.
+// Only the worker that processes the queue should call it.
 func (c *iconCache) loadQueue() error {
 	var (
-		seen    = map[int64]bool{}
-		f       *os.File
-		r       *csv.Reader
-		records [][]string
-		err     error
+		seen = map[int64]bool{}
+		f    *os.File
+		r    *csv.Reader
+		err  error
 	)
-	if f, err = os.Open(c.queueFile); err != nil {
-		if !os.IsNotExist(err) {
-			return errors.Wrap(err, "read icon queue")
-		}
-		return nil
+	for _, icon := range c.Queue {
+		seen[icon.ID] = true
+	}
+	if f, err = c.lockQueue(os.O_RDWR); err != nil {
+		return err
 	}
 	defer f.Close()
 
-	c.Queue = []cacheIcon{}
 	r = csv.NewReader(f)
 	r.Comma = '\t'
-	r.FieldsPerRecord = 2
-	if records, err = r.ReadAll(); err != nil {
-		return errors.Wrap(err, "load queue")
-	}
-	for _, row := range records {
-		id, _ := strconv.ParseInt(row[0], 10, 64)
-		if seen[id] {
+	r.FieldsPerRecord = -1
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// skip unreadable entries rather than failing: the queue is
+			// cleared regardless, so a corrupt line can't block it forever
+			log.Printf("[icons] ignoring invalid queue entry: %v", err)
+			if _, ok := err.(*csv.ParseError); ok {
+				continue
+			}
+			break
+		}
+		var icon cacheIcon
+		switch {
+		case len(row) == 2: // queued by an older version
+			icon.URLs = row[1:]
+		case len(row) > 2:
+			icon.Title, icon.Author, icon.URLs = row[1], row[2], row[3:]
+		default:
+			log.Printf("[icons] ignoring invalid queue entry: %#v", row)
 			continue
 		}
-		c.Queue = append(c.Queue, cacheIcon{ID: id, URL: row[1]})
-		seen[id] = true
+		icon.ID, _ = strconv.ParseInt(row[0], 10, 64)
+		if seen[icon.ID] {
+			continue
+		}
+		c.Queue = append(c.Queue, icon)
+		seen[icon.ID] = true
 	}
 
-	if err = f.Close(); err != nil {
-		return errors.Wrap(err, "close queue")
-	}
 	// clear queue
-	if err = atomic.WriteFile(c.queueFile, &bytes.Buffer{}); err != nil {
+	if err = f.Truncate(0); err != nil {
 		return errors.Wrap(err, "clear queue")
 	}
-
 	return nil
 }
 
-// Close atomically writes queue to disk.
+// Close appends Queue to the queue file.
 func (c *iconCache) Close() error {
 	var (
 		buf = &bytes.Buffer{}
@@ -207,7 +385,8 @@ func (c *iconCache) Close() error {
 	w.Comma = '\t'
 
 	for _, icon := range c.Queue {
-		if err := w.Write([]string{fmt.Sprintf("%d", icon.ID), icon.URL}); err != nil {
+		row := append([]string{fmt.Sprintf("%d", icon.ID), icon.Title, icon.Author}, icon.URLs...)
+		if err := w.Write(row); err != nil {
 			return errors.Wrapf(err, "write icon %#v", icon)
 		}
 	}
@@ -217,7 +396,15 @@ func (c *iconCache) Close() error {
 		return errors.Wrap(err, "write TSV")
 	}
 
-	if err := atomic.WriteFile(c.queueFile, buf); err != nil {
+	f, err := c.lockQueue(os.O_WRONLY | os.O_APPEND)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return errors.Wrap(err, "write queue file")
+	}
+	if err := f.Close(); err != nil {
 		return errors.Wrap(err, "write queue file")
 	}
 
@@ -226,15 +413,18 @@ func (c *iconCache) Close() error {
 	return nil
 }
 
-// ProcessQueue downloads pending icons.
+// ProcessQueue retrieves pending icons. Each source is tried in turn, and
+// a placeholder cover is generated if none succeeds.
 func (c *iconCache) ProcessQueue() error {
 	if !c.HasQueue() {
 		return nil
 	}
 
 	type status struct {
-		icon cacheIcon
-		err  error
+		icon   cacheIcon
+		source string   // URL of retrieved cover or "placeholder"
+		failed []string // URLs that couldn't be retrieved
+		err    error
 	}
 
 	var (
@@ -253,36 +443,38 @@ func (c *iconCache) ProcessQueue() error {
 			defer func() { <-pool }()
 
 			var (
-				img image.Image
-				buf = &bytes.Buffer{}
-				err error
+				img   image.Image
+				large = c.largeFile(icon.ID)
+				st    = status{icon: icon}
+				err   error
 			)
 
-			if util.PathExists(icon.Path) {
+			if util.PathExists(icon.Path) && util.PathExists(large) {
 				return
 			}
 
-			if img, err = remoteImage(icon.URL); err != nil {
-				ch <- status{err: errors.Wrapf(err, "download %q", icon.URL)}
-				return
-			}
-			img = squareImage(img)
-			if err = os.MkdirAll(filepath.Dir(icon.Path), 0700); err != nil {
-				ch <- status{err: errors.Wrapf(err, "cache directory %q", filepath.Dir(icon.Path))}
-				return
+			for _, URL := range icon.URLs {
+				if img, err = remoteImage(URL); err == nil {
+					st.source = URL
+					break
+				}
+				logIfError(err, "download %q: %v", URL)
+				st.failed = append(st.failed, URL)
 			}
-
-			if err = png.Encode(buf, img); err != nil {
-				ch <- status{err: errors.Wrapf(err, "convert image %q", icon.URL)}
+			if img == nil {
+				st.icon.Path = c.placeholderFile(icon.ID)
+				st.source = "placeholder"
+				st.err = saveImage(st.icon.Path, placeholderCover(icon))
+				ch <- st
 				return
 			}
 
-			if err = atomic.WriteFile(icon.Path, buf); err != nil {
-				ch <- status{err: errors.Wrapf(err, "save image %q", icon.URL)}
-				return
+			if err = saveImage(large, img); err == nil {
+				img = imaging.Fit(img, coverIconSize, coverIconSize, imaging.Lanczos)
+				err = saveImage(icon.Path, squareImage(img))
 			}
-
-			ch <- status{icon: icon}
+			st.err = errors.Wrapf(err, "cache %q", st.source)
+			ch <- st
 		}(icon)
 	}
 
@@ -298,24 +490,24 @@ func (c *iconCache) ProcessQueue() error {
 	)
 	for st := range ch {
 		n++
+		for _, URL := range st.failed {
+			c.addFailure(URL)
+		}
 		if st.err != nil {
 			logIfError(st.err, "cache icon: %v")
 			err = st.err
-		} else {
-			log.Printf("[icons] [%3d/%d] cached %q to %q", n, len(c.Queue), st.icon.URL, st.icon.Path)
+			continue
 		}
+		if st.source != "placeholder" {
+			delete(c.failures, st.source)
+		}
+		log.Printf("[icons] [%3d/%d] cached %q to %q", n, len(c.Queue), st.source, st.icon.Path)
 	}
 	c.Queue = []cacheIcon{}
+	logIfError(c.saveFailures(), "save cover failures: %v")
 	return err
 }
 
-func cachefile(key string, ext ...string) string {
-	ext = append([]string{filepath.Ext(key)}, ext...)
-	s := hash(key)
-	path := s[0:2] + "/" + s[2:4] + "/" + s
-	return path + strings.Join(ext, "")
-}
-
 func cachefileID(id int64, ext ...string) string {
 	x := "json"
 	if len(ext) > 0 {
@@ -328,6 +520,29 @@ func cachefileID(id int64, ext ...string) string {
 	return fmt.Sprintf("%s/%s/%d.%s", s[0:2], s[2:4], id, x)
 }
 
+// save image as JPEG or PNG depending on path's extension.
+func saveImage(path string, img image.Image) error {
+	var (
+		buf = &bytes.Buffer{}
+		err error
+	)
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrapf(err, "cache directory %q", filepath.Dir(path))
+	}
+	if filepath.Ext(path) == ".jpg" {
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: coverJPEGQuality})
+	} else {
+		err = png.Encode(buf, img)
+	}
+	if err != nil {
+		return errors.Wrap(err, "encode image")
+	}
+	if err = atomic.WriteFile(path, buf); err != nil {
+		return errors.Wrap(err, "save image")
+	}
+	return nil
+}
+
 func remoteImage(URL string) (image.Image, error) {
 	var (
 		img image.Image
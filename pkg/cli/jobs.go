@@ -0,0 +1,70 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+	"go.deanishe.net/alfred-booksearch/pkg/jobs"
+)
+
+// most jobs that call the API (shelves, authors, series, books) to run at
+// once. They share the API rate limit, so more jobs wouldn't fetch data
+// any faster.
+const maxAPIJobs = 3
+
+// schedules background jobs that call the API
+var apiJobs *jobs.Scheduler
+
+// create scheduler for API jobs. Its state is kept in the cache directory.
+func newAPIScheduler() *jobs.Scheduler {
+	return &jobs.Scheduler{
+		Path:    filepath.Join(wf.CacheDir(), "api_jobs.json"),
+		Max:     maxAPIJobs,
+		Running: wf.IsRunning,
+		Start: func(j jobs.Job) error {
+			// tell the job its name, so it can hand its place to the next job
+			return runJob(j.Name, append([]string{"-job", j.Name}, j.Args...)...)
+		},
+	}
+}
+
+// arguments for job that caches shelf opts.ShelfName in order.
+func shelfJobArgs(order gr.ShelfSort) []string {
+	return []string{"-saveshelf", opts.ShelfName, string(order), fmt.Sprintf("%d", opts.ShelfID)}
+}
+
+// parse job argument i as an ID. Jobs get the ID of the resource they fetch
+// as an argument, as a queued job is started by whichever process has a
+// free slot, and inherits its environment.
+func argID(i int) int64 {
+	if i >= len(opts.Args) {
+		checkErr(errors.Errorf("missing argument %d", i+1))
+	}
+	id, err := strconv.ParseInt(opts.Args[i], 10, 64)
+	checkErr(errors.Wrap(err, "parse ID"))
+	return id
+}
+
+// start a background job that calls the API. If maxAPIJobs are already
+// running, the job is queued and started when one of them finishes.
+func runAPIJob(name string, args ...string) error {
+	_, err := apiJobs.Run(jobs.Job{Name: name, Args: args})
+	return err
+}
+
+// let the next queued API job start. Called when an API job finishes.
+// run defers it, which also covers panics (checkErr), but exits via
+// log.Fatal and os.Exit skip deferred calls, so they must call it first.
+func apiJobDone() {
+	if opts.FlagJob != "" {
+		logIfError(apiJobs.Done(opts.FlagJob), "start next job: %v")
+		opts.FlagJob = ""
+	}
+}
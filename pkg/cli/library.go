@@ -0,0 +1,367 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	aw "github.com/deanishe/awgo"
+	"github.com/pkg/errors"
+	"go.deanishe.net/fuzzy"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+const (
+	libraryKey = "library.json"
+	// how often to check caches for new books
+	libraryMaxAge = time.Minute
+)
+
+// library is an index of every book in the workflow's caches.
+type library struct {
+	// cache files that have been indexed, keyed by path relative to cache directory
+	Sources map[string]librarySource
+	Books   map[int64]libraryEntry
+}
+
+// librarySource is an indexed cache file.
+type librarySource struct {
+	ModTime time.Time
+	IDs     []int64
+}
+
+// libraryEntry is an indexed book.
+type libraryEntry struct {
+	Book gr.Book
+	Text string // lowercase plaintext description for full-text search
+}
+
+// load library index from cache.
+func loadLibrary() (*library, error) {
+	l := &library{
+		Sources: map[string]librarySource{},
+		Books:   map[int64]libraryEntry{},
+	}
+	if !wf.Cache.Exists(libraryKey) {
+		return l, nil
+	}
+	if err := wf.Cache.LoadJSON(libraryKey, l); err != nil {
+		return nil, errors.Wrap(err, "load library")
+	}
+	return l, nil
+}
+
+// Save library index to cache.
+func (l *library) Save() error {
+	return wf.Cache.StoreJSON(libraryKey, l)
+}
+
+// Update re-indexes new and changed cache files and removes books from
+// files that have been deleted. It returns true if the index was changed.
+func (l *library) Update() (bool, error) {
+	type source struct {
+		key string
+		fi  os.FileInfo
+	}
+	var (
+		root    = caches.Dir
+		seen    = map[string]bool{}
+		changed []source
+	)
+	for _, dir := range caches.Dirs() {
+		err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if fi.IsDir() || filepath.Ext(p) != ".json" {
+				return nil
+			}
+			key, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			seen[key] = true
+			if src, ok := l.Sources[key]; !ok || !src.ModTime.Equal(fi.ModTime()) {
+				changed = append(changed, source{key, fi})
+			}
+			return nil
+		})
+		if err != nil {
+			return false, errors.Wrap(err, "scan cache")
+		}
+	}
+
+	var dirty bool
+	for key := range l.Sources {
+		if !seen[key] {
+			log.Printf("[library] removing %s ...", key)
+			delete(l.Sources, key)
+			dirty = true
+		}
+	}
+
+	// index oldest first, so newer data take precedence
+	sort.Slice(changed, func(i, j int) bool { return changed[i].fi.ModTime().Before(changed[j].fi.ModTime()) })
+	for _, src := range changed {
+		books, err := loadCachedBooks(filepath.Join(root, src.key))
+		if err != nil {
+			// record file anyway, so it isn't re-read until it changes
+			log.Printf("[library] ignoring %s: %v", src.key, err)
+		}
+		ids := make([]int64, 0, len(books))
+		for _, b := range books {
+			if b.ID == 0 {
+				continue
+			}
+			l.add(b)
+			ids = append(ids, b.ID)
+		}
+		l.Sources[src.key] = librarySource{ModTime: src.fi.ModTime(), IDs: ids}
+		log.Printf("[library] indexed %d book(s) from %s", len(ids), src.key)
+		dirty = true
+	}
+
+	if dirty {
+		l.prune()
+	}
+	return dirty, nil
+}
+
+// add book to index, merging it with any existing entry.
+func (l *library) add(b gr.Book) {
+	if e, ok := l.Books[b.ID]; ok {
+		b = mergeBook(e.Book, b)
+	}
+	l.Books[b.ID] = libraryEntry{
+		Book: b,
+		Text: strings.ToLower(b.DescriptionText()),
+	}
+}
+
+// remove books that are no longer in any cache file.
+func (l *library) prune() {
+	ids := map[int64]bool{}
+	for _, src := range l.Sources {
+		for _, id := range src.IDs {
+			ids[id] = true
+		}
+	}
+	for id := range l.Books {
+		if !ids[id] {
+			delete(l.Books, id)
+		}
+	}
+}
+
+// Search returns books whose title, series, author or ISBN match query,
+// followed by books whose description contains every word of query.
+// If query is empty, all books are returned sorted by title.
+func (l *library) Search(query string) []gr.Book {
+	var (
+		books   = make(libraryBooks, 0, len(l.Books))
+		words   = strings.Fields(strings.ToLower(query))
+		matches []gr.Book
+		others  []gr.Book
+	)
+	for _, e := range l.Books {
+		books = append(books, e)
+	}
+	sort.Sort(books)
+
+	if len(words) == 0 {
+		for _, e := range books {
+			matches = append(matches, e.Book)
+		}
+		return matches
+	}
+
+	for i, r := range fuzzy.New(books).Sort(query) {
+		e := books[i]
+		if r.Match {
+			matches = append(matches, e.Book)
+		} else if containsAll(e.Text, words) {
+			others = append(others, e.Book)
+		}
+	}
+	return append(matches, others...)
+}
+
+// libraryBooks sorts entries by title.
+type libraryBooks []libraryEntry
+
+// Implement sort.Interface
+func (s libraryBooks) Len() int           { return len(s) }
+func (s libraryBooks) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s libraryBooks) Less(i, j int) bool { return s[i].Book.Title < s[j].Book.Title }
+func (s libraryBooks) Keywords(i int) string {
+	b := s[i].Book
+	kw := []string{b.Title, b.Series.Title}
+	for _, se := range b.OtherSeries {
+		kw = append(kw, se.Title)
+	}
+	kw = append(kw, b.Author.Name, b.ISBN, b.ISBN13)
+	return strings.Join(kw, " ")
+}
+
+// returns true if s contains all words.
+func containsAll(s string, words []string) bool {
+	if len(words) == 0 {
+		return false
+	}
+	for _, w := range words {
+		if !strings.Contains(s, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// merge two versions of a book. Set fields of b take precedence.
+func mergeBook(old, b gr.Book) gr.Book {
+	if b.WorkID == 0 {
+		b.WorkID = old.WorkID
+	}
+	if b.ISBN == "" {
+		b.ISBN = old.ISBN
+	}
+	if b.ISBN13 == "" {
+		b.ISBN13 = old.ISBN13
+	}
+	if b.TitleNoSeries == "" {
+		b.TitleNoSeries = old.TitleNoSeries
+	}
+	if !b.HasSeries() {
+		b.Series = old.Series
+	}
+	if len(b.OtherSeries) == 0 {
+		b.OtherSeries = old.OtherSeries
+	}
+	if b.Author.ID == 0 {
+		b.Author.ID, b.Author.URL = old.Author.ID, old.Author.URL
+	}
+	if b.PubDate.IsZero() {
+		b.PubDate, b.PubDatePrecision = old.PubDate, old.PubDatePrecision
+	}
+	if b.EditionPubDate.IsZero() {
+		b.EditionPubDate, b.EditionPubDatePrecision = old.EditionPubDate, old.EditionPubDatePrecision
+	}
+	if b.Description == "" {
+		b.Description = old.Description
+	}
+	if b.ImageURL == "" {
+		b.ImageURL = old.ImageURL
+	}
+	return b
+}
+
+// load books from a cache file. The format depends on the cache directory.
+func loadCachedBooks(path string) ([]gr.Book, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var books []gr.Book
+	switch {
+	case strings.HasPrefix(path, caches.Books.Dir()+"/"):
+		var b gr.Book
+		err = json.Unmarshal(data, &b)
+		books = []gr.Book{b}
+	case strings.HasPrefix(path, caches.Series.Dir()+"/"):
+		var s gr.Series
+		err = json.Unmarshal(data, &s)
+		books = s.Books
+	case strings.HasPrefix(path, caches.Shelves.Dir()+"/"):
+		var s gr.Shelf
+		err = json.Unmarshal(data, &s)
+		books = s.Books
+	default: // authors & queries
+		err = json.Unmarshal(data, &books)
+	}
+	return books, err
+}
+
+// search cached books
+func runLibrary() {
+	wf.Var("last_action", "library")
+	wf.Var("last_query", opts.Query)
+
+	rerun := wf.IsRunning(libraryJob)
+	if wf.Cache.Expired(libraryKey, libraryMaxAge) {
+		rerun = true
+		checkErr(runJob(libraryJob, "-savelibrary"))
+	}
+
+	lib, err := loadLibrary()
+	checkErr(err)
+	log.Printf("[library] %d book(s) in library", len(lib.Books))
+
+	if opts.QueryEmpty() {
+		wf.NewItem("Search Library").
+			Subtitle(fmt.Sprintf("%d book(s) you've already seen", len(lib.Books))).
+			Icon(iconBook)
+		addNavActions("library")
+		if rerun {
+			wf.Rerun(rerunInterval)
+		}
+		wf.SendFeedback()
+		return
+	}
+
+	var (
+		icons = newIconCache(iconCacheDir)
+		mods  = LoadModifiers()
+	)
+
+	q := gr.ParseQuery(opts.Query)
+	for _, b := range q.Apply(lib.Search(q.Text())) {
+		bookItem(b, icons, mods)
+	}
+
+	addNavActions("library")
+
+	wf.WarnEmpty("No Matching Books", "Try a different query?")
+
+	if icons.HasQueue() {
+		var err error
+		if err = icons.Close(); err == nil {
+			err = runJob(iconsJob, "-icons")
+		}
+		logIfError(err, "cache icons: %v")
+	}
+
+	if rerun || wf.IsRunning(iconsJob) {
+		wf.Rerun(rerunInterval)
+	}
+
+	wf.SendFeedback()
+}
+
+// update library index
+func runCacheLibrary() {
+	wf.Configure(aw.TextErrors(true))
+
+	lib, err := loadLibrary()
+	checkErr(err)
+
+	changed, err := lib.Update()
+	checkErr(err)
+	if changed {
+		log.Printf("[library] %d book(s) in library", len(lib.Books))
+	}
+	// also save unchanged index to reset its age
+	checkErr(lib.Save())
+}
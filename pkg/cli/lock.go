@@ -0,0 +1,27 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// call fn while holding an exclusive lock on path, so the workflow and its
+// background jobs can't read, modify and save the same file at once. path
+// must be a dedicated lock file, not the data file itself: awgo replaces
+// files when it saves them, so processes would lock different files.
+func withLock(path string, fn func() error) error {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrap(err, "open lock file")
+	}
+	defer f.Close()
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		return errors.Wrap(err, "acquire lock")
+	}
+	return fn()
+}
@@ -36,6 +36,26 @@ func (m Modifier) String() string {
 	return fmt.Sprintf("Modifier{Keys: %v, Script: %q}", m.Keys, m.Script.Path)
 }
 
+// Uses returns true if Modifier is triggered by exactly the given keys.
+func (m Modifier) Uses(keys ...aw.ModKey) bool {
+	if len(m.Keys) != len(keys) {
+		return false
+	}
+	for _, k := range keys {
+		var found bool
+		for _, mk := range m.Keys {
+			if mk == k {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 /*
 func lookup(data map[string]string) func(string) string {
 	return func(key string) string { return data[key] }
@@ -104,6 +124,16 @@ func LoadModifiers() []Modifier {
 	return mods
 }
 
+// returns true if one of the user's custom hotkeys uses exactly keys.
+func modifierUsed(mods []Modifier, keys ...aw.ModKey) bool {
+	for _, m := range mods {
+		if m.Uses(keys...) {
+			return true
+		}
+	}
+	return false
+}
+
 /*
 func LoadModifiers() []Modifier {
 	var mods []Modifier
@@ -12,6 +12,8 @@ import (
 
 	"github.com/deanishe/awgo/keychain"
 	"github.com/pkg/errors"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
 )
 
 const (
@@ -37,6 +39,8 @@ func init() {
 	opts.MaxCache.Icons = 336 * time.Hour // 14 days
 	opts.MaxCache.Shelf = 5 * time.Minute
 	opts.MaxCache.Feeds = 90 * time.Minute
+	opts.MaxCoverCache = 200
+	opts.CoverBadges = true
 }
 
 type options struct {
@@ -48,12 +52,15 @@ type options struct {
 		Icons   time.Duration
 		Feeds   time.Duration
 	}
+	MaxCoverCache  int    `env:"MAX_COVER_CACHE"` // Max. size of cover cache in MB (0 = unlimited)
 	AccessToken    string // OAuth token
 	AccessSecret   string // OAuth secret
 	MinQueryLength int    // Minimum length of search query
 
 	// Whether to always export book details to scripts
 	ExportDetails bool
+	// Whether to show shelf & rating badges on covers
+	CoverBadges bool
 
 	// RSS feed/shelves data
 	UserID   int64  // User's Goodreads ID
@@ -75,6 +82,8 @@ type options struct {
 	ShelfID    int64
 	ShelfName  string
 	ShelfTitle string
+	ShelfSort  string
+	BulkAction string
 	SeriesID   int64
 	SeriesName string `env:"SERIES"`
 
@@ -88,6 +97,14 @@ type options struct {
 	FlagRemoveFromShelf bool `env:"-"`
 	FlagSelectShelf     bool `env:"-"`
 	FlagSelectShelves   bool `env:"-"`
+	FlagSelectBook      bool `env:"-"`
+	FlagBulk            bool `env:"-"`
+	FlagReplay          bool `env:"-"`
+	FlagRetry           bool `env:"-"`
+	FlagDiscard         bool `env:"-"`
+	FlagUndo            bool `env:"-"`
+	FlagFinish          bool `env:"-"`
+	FlagStart           bool `env:"-"`
 	FlagCacheShelves    bool `env:"-"`
 	FlagReloadShelf     bool `env:"-"`
 	FlagReloadShelves   bool `env:"-"`
@@ -99,15 +116,30 @@ type options struct {
 	FlagScript          bool `env:"-"`
 	FlagScripts         bool `env:"-"`
 	FlagSearch          bool `env:"-"`
+	FlagLibrary         bool `env:"-"`
+	FlagCacheLibrary    bool `env:"-"`
 	FlagSeries          bool `env:"-"`
 	FlagCacheSeries     bool `env:"-"`
 	FlagCacheBook       bool `env:"-"`
 	FlagUserInfo        bool `env:"-"`
 	FlagHousekeeping    bool `env:"-"`
+	FlagClearCache      bool `env:"-"`
+	FlagRebuildCovers   bool `env:"-"`
+	FlagClearHistory    bool `env:"-"`
+	FlagPinned          bool `env:"-"`
+	FlagPin             bool `env:"-"`
+	FlagUnpin           bool `env:"-"`
+	FlagPinAuthor       bool `env:"-"`
+	FlagUnpinAuthor     bool `env:"-"`
 	FlagIcons           bool `env:"-"`
 	FlagHelp            bool `env:"-"`
 	FlagNoop            bool `env:"-"`
 
+	// shelves to remove book from (with -add)
+	FlagDeselected string `env:"-"`
+	// name of background job, if run by the scheduler
+	FlagJob string `env:"-"`
+
 	// script helper functions
 	FlagExport        bool   `env:"-"`
 	FlagJSON          bool   `env:"-"`
@@ -128,9 +160,9 @@ type options struct {
 // QueryEmpty returns true if trimmed query is empty.
 func (opts *options) QueryEmpty() bool { return strings.TrimSpace(opts.Query) == "" }
 
-// QueryTooShort returns true if query is empty.
+// QueryTooShort returns true if free text of query is too short to search for.
 func (opts *options) QueryTooShort() bool {
-	return len(strings.TrimSpace(opts.Query)) < opts.MinQueryLength
+	return len(gr.ParseQuery(opts.Query).Text()) < opts.MinQueryLength
 }
 
 // Authorised returns true if workflow has an OAuth token.
@@ -144,6 +176,9 @@ func (opts *options) Prepare(args []string) error {
 	fs.BoolVar(&opts.FlagSearch, "search", false, "search for books")
 	fs.BoolVar(&opts.FlagConf, "conf", false, "show workflow configuration")
 
+	fs.BoolVar(&opts.FlagLibrary, "library", false, "search cached books")
+	fs.BoolVar(&opts.FlagCacheLibrary, "savelibrary", false, "update index of cached books")
+
 	fs.BoolVar(&opts.FlagAuthor, "author", false, "list books for author")
 	fs.BoolVar(&opts.FlagCacheAuthor, "savebooks", false, "cache all books by author")
 
@@ -160,11 +195,29 @@ func (opts *options) Prepare(args []string) error {
 	fs.BoolVar(&opts.FlagRemoveFromShelf, "remove", false, "remove book from shelf")
 	fs.BoolVar(&opts.FlagSelectShelves, "selection", false, "select shelves to add a book to")
 	fs.BoolVar(&opts.FlagSelectShelf, "select", false, "toggle shelf selected")
+	fs.StringVar(&opts.FlagDeselected, "deselected", "", "comma-separated shelves to remove book from")
+	fs.BoolVar(&opts.FlagSelectBook, "selectbook", false, "toggle book selected for bulk actions")
+	fs.BoolVar(&opts.FlagBulk, "bulk", false, "copy, move or remove selected books")
 	fs.BoolVar(&opts.FlagReloadShelf, "reload", false, "reload shelf")
 	fs.BoolVar(&opts.FlagReloadShelves, "reloadshelves", false, "reload shelves")
+	fs.BoolVar(&opts.FlagReplay, "replay", false, "send queued shelf changes")
+	fs.BoolVar(&opts.FlagRetry, "retry", false, "retry queued or failed shelf change")
+	fs.BoolVar(&opts.FlagDiscard, "discard", false, "delete queued or failed shelf change")
+	fs.BoolVar(&opts.FlagUndo, "undo", false, "reverse last shelf change")
+	fs.BoolVar(&opts.FlagFinish, "finish", false, "mark book as read today")
+	fs.BoolVar(&opts.FlagStart, "start", false, "mark book as started today")
+
+	fs.BoolVar(&opts.FlagPinned, "pinned", false, "list pinned books & authors")
+	fs.BoolVar(&opts.FlagPin, "pin", false, "pin book")
+	fs.BoolVar(&opts.FlagUnpin, "unpin", false, "unpin book")
+	fs.BoolVar(&opts.FlagPinAuthor, "pinauthor", false, "pin author")
+	fs.BoolVar(&opts.FlagUnpinAuthor, "unpinauthor", false, "unpin author")
 
 	fs.BoolVar(&opts.FlagFeeds, "feeds", false, "fetch RSS feeds")
 	fs.BoolVar(&opts.FlagHousekeeping, "housekeeping", false, "check for a new version & clear stale caches")
+	fs.BoolVar(&opts.FlagClearCache, "clearcache", false, "delete cached data of a type (or all)")
+	fs.BoolVar(&opts.FlagRebuildCovers, "rebuildcovers", false, "delete & re-download book covers")
+	fs.BoolVar(&opts.FlagClearHistory, "clearhistory", false, "delete search & book history")
 	fs.BoolVar(&opts.FlagIcons, "icons", false, "download queued icons")
 	fs.BoolVar(&opts.FlagAuthorise, "authorise", false, "intiate OAuth authorisation flow")
 	fs.BoolVar(&opts.FlagDeauthorise, "deauthorise", false, "delete OAuth credentials")
@@ -180,6 +233,7 @@ func (opts *options) Prepare(args []string) error {
 
 	fs.BoolVar(&opts.FlagBeep, "beep", false, `play "morse" sound`)
 	fs.BoolVar(&opts.FlagNoop, "noop", false, "do nothing")
+	fs.StringVar(&opts.FlagJob, "job", "", "name of scheduled background job")
 
 	fs.StringVar(&opts.FlagNotify, "notify", "", "show notification")
 	fs.StringVar(&opts.FlagNotifyMessage, "message", "", "show notification")
@@ -0,0 +1,224 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"fmt"
+	"log"
+
+	aw "github.com/deanishe/awgo"
+	"github.com/pkg/errors"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+// pins are kept in the data directory, so housekeeping and clearing
+// the caches don't delete them.
+const pinsKey = "pins.json"
+
+// pinned books & authors, loaded by isPinned
+var pinned *pins
+
+// pins are the user's pinned books and authors in the order they were pinned.
+type pins struct {
+	Books   []gr.Book
+	Authors []gr.Author
+}
+
+// load pins from data directory.
+func loadPins() (*pins, error) {
+	p := &pins{}
+	if !wf.Data.Exists(pinsKey) {
+		return p, nil
+	}
+	if err := wf.Data.LoadJSON(pinsKey, p); err != nil {
+		return nil, errors.Wrap(err, "load pins")
+	}
+	return p, nil
+}
+
+// Save pins to data directory.
+func (p *pins) Save() error {
+	return wf.Data.StoreJSON(pinsKey, p)
+}
+
+// HasBook returns true if book is pinned.
+func (p *pins) HasBook(id int64) bool {
+	for _, b := range p.Books {
+		if b.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAuthor returns true if author is pinned.
+func (p *pins) HasAuthor(id int64) bool {
+	for _, a := range p.Authors {
+		if a.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// PinBook adds book to pins or updates the pinned copy.
+func (p *pins) PinBook(b gr.Book) {
+	for i, pb := range p.Books {
+		if pb.ID == b.ID {
+			p.Books[i] = b
+			return
+		}
+	}
+	p.Books = append(p.Books, b)
+}
+
+// UnpinBook removes book from pins.
+func (p *pins) UnpinBook(id int64) {
+	books := []gr.Book{}
+	for _, b := range p.Books {
+		if b.ID != id {
+			books = append(books, b)
+		}
+	}
+	p.Books = books
+}
+
+// PinAuthor adds author to pins.
+func (p *pins) PinAuthor(a gr.Author) {
+	if !p.HasAuthor(a.ID) {
+		p.Authors = append(p.Authors, a)
+	}
+}
+
+// UnpinAuthor removes author from pins.
+func (p *pins) UnpinAuthor(id int64) {
+	authors := []gr.Author{}
+	for _, a := range p.Authors {
+		if a.ID != id {
+			authors = append(authors, a)
+		}
+	}
+	p.Authors = authors
+}
+
+// returns true if book is pinned. Pins are loaded on first call.
+func isPinned(id int64) bool {
+	if pinned == nil {
+		var err error
+		if pinned, err = loadPins(); err != nil {
+			log.Printf("[pins] %v", err)
+			pinned = &pins{}
+		}
+	}
+	return pinned.HasBook(id)
+}
+
+// Show pinned authors and books.
+func runPinned() {
+	wf.Var("last_action", "pinned")
+	wf.Var("last_query", opts.Query)
+
+	p, err := loadPins()
+	checkErr(err)
+	pinned = p
+
+	if opts.QueryEmpty() {
+		wf.Configure(aw.SuppressUIDs(true))
+	}
+
+	for _, a := range p.Authors {
+		id := fmt.Sprintf("%d", a.ID)
+		it := wf.NewItem(a.Name).
+			Subtitle("Pinned author · ↩ to view books").
+			UID("author-"+id).
+			Valid(true).
+			Icon(iconAuthor).
+			Var("AUTHOR_ID", id).
+			Var("AUTHOR_NAME", a.Name).
+			Var("AUTHOR_URL", a.URL).
+			Var("action", "author").
+			Var("query", "").
+			Var("hide_alfred", "").
+			Var("passvars", "true")
+
+		it.NewModifier(aw.ModShift).
+			Subtitle("Unpin Author").
+			Arg("-unpinauthor").
+			Icon(iconDelete).
+			Var("action", "pinned").
+			Var("query", opts.Query).
+			Var("passvars", "true")
+	}
+
+	var (
+		icons = newIconCache(iconCacheDir)
+		mods  = LoadModifiers()
+	)
+	for _, b := range p.Books {
+		bookItem(b, icons, mods)
+	}
+
+	addNavActions("pinned")
+
+	if !opts.QueryEmpty() {
+		wf.Filter(opts.Query)
+	}
+
+	wf.WarnEmpty("No Pinned Books or Authors", "Use ⇧↩ or the Pin Book action on a book to pin it")
+
+	if icons.HasQueue() {
+		var err error
+		if err = icons.Close(); err == nil {
+			err = runJob(iconsJob, "-icons")
+		}
+		logIfError(err, "cache icons: %v")
+	}
+
+	if wf.IsRunning(iconsJob) {
+		wf.Rerun(rerunInterval)
+	}
+
+	wf.SendFeedback()
+}
+
+// pin or unpin a book or author.
+func runPin() {
+	wf.Configure(aw.TextErrors(true))
+
+	p, err := loadPins()
+	checkErr(err)
+
+	var title, msg string
+	switch {
+	case opts.FlagPin && p.HasBook(opts.BookID): // "Pin Book" action toggles
+		p.UnpinBook(opts.BookID)
+		title, msg = opts.BookTitle, "Unpinned"
+	case opts.FlagPin:
+		// pin full details if possible, so the book can be shown offline
+		b, err := bookDetails(opts.BookID)
+		if err != nil {
+			log.Printf("[pins] book details: %v", err)
+			b = bookFromEnv()
+		}
+		p.PinBook(b)
+		title, msg = b.Title, "Pinned"
+	case opts.FlagUnpin:
+		p.UnpinBook(opts.BookID)
+		title, msg = opts.BookTitle, "Unpinned"
+	case opts.FlagPinAuthor:
+		p.PinAuthor(gr.Author{ID: opts.AuthorID, Name: opts.AuthorName, URL: wf.Config.Get("AUTHOR_URL")})
+		title, msg = opts.AuthorName, "Author pinned"
+	case opts.FlagUnpinAuthor:
+		p.UnpinAuthor(opts.AuthorID)
+		title, msg = opts.AuthorName, "Author unpinned"
+	}
+
+	if err := p.Save(); err != nil {
+		notifyError("Save Pins Failed", err)
+		log.Fatalf("[ERROR] save pins: %v", err)
+	}
+	log.Printf("[pins] %d book(s), %d author(s)", len(p.Books), len(p.Authors))
+	checkErr(notify(title, msg))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"strings"
+
+	"go.deanishe.net/fuzzy"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+// filter books with the filters & sort order of query. Returns the matching
+// books and the free text of the query, which should be passed to wf.Filter.
+// If query has a sort order, free text is matched here instead, so Alfred's
+// fuzzy sorting doesn't override the order, and the returned text is empty.
+func filterBooks(books []gr.Book, query string) ([]gr.Book, string) {
+	var (
+		q    = gr.ParseQuery(query)
+		text = strings.TrimSpace(q.Text())
+	)
+	books = q.Apply(books)
+	if q.Sort == "" || text == "" {
+		return books, text
+	}
+
+	// fuzzy.Sorter sorts in place, so sort a copy
+	var (
+		sorted  = append(fuzzyBooks{}, books...)
+		matches = map[int64]bool{}
+		result  []gr.Book
+	)
+	for i, r := range fuzzy.New(sorted).Sort(text) {
+		if r.Match {
+			matches[sorted[i].ID] = true
+		}
+	}
+	for _, b := range books {
+		if matches[b.ID] {
+			result = append(result, b)
+		}
+	}
+	return result, ""
+}
+
+// fuzzyBooks makes books fuzzy-sortable by title and author.
+type fuzzyBooks []gr.Book
+
+// Implement sort.Interface
+func (s fuzzyBooks) Len() int              { return len(s) }
+func (s fuzzyBooks) Swap(i, j int)         { s[i], s[j] = s[j], s[i] }
+func (s fuzzyBooks) Less(i, j int) bool    { return s[i].Title < s[j].Title }
+func (s fuzzyBooks) Keywords(i int) string { return s[i].Title + " " + s[i].Author.Name }
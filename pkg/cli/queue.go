@@ -0,0 +1,586 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	aw "github.com/deanishe/awgo"
+	"github.com/pkg/errors"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+const (
+	// queued changes are user data, so they're kept in the data directory,
+	// not the cache, which may be cleared.
+	queueKey = "shelf_queue.json"
+	// how many times replay job retries a change before giving up till
+	// the next time it's started. The delay doubles after each attempt.
+	maxRetries = 5
+	retryDelay = 10 * time.Second
+)
+
+// shelfChange is a change to the user's shelves, corresponding to one
+// API request.
+type shelfChange struct {
+	ID       int64     // unique ID
+	Title    string    // book title (or number of books) for display
+	Books    []int64   // IDs of books to add or remove
+	Shelves  []string  // names of shelves
+	Remove   bool      // remove books from shelves instead of adding them
+	Dates    bool      // also set date books were finished or started
+	Time     time.Time // when change was made
+	Attempts int       // how many times change has failed
+	Error    string    // last error
+	// shelves each book was on before change was queued
+	Prior map[int64][]string
+}
+
+// last ID returned by newChangeID
+var lastChangeID int64
+
+// returns a unique, increasing ID for a shelfChange.
+func newChangeID() int64 {
+	id := time.Now().UnixNano()
+	if id <= lastChangeID {
+		id = lastChangeID + 1
+	}
+	lastChangeID = id
+	return id
+}
+
+// change that adds books to shelves.
+func addChange(title string, ids []int64, shelves []string) shelfChange {
+	return shelfChange{ID: newChangeID(), Title: title, Books: ids, Shelves: shelves, Time: time.Now()}
+}
+
+// change that removes a book from a shelf. The API can only remove one
+// book from one shelf at a time.
+func removeChange(title string, id int64, shelf string) shelfChange {
+	return shelfChange{ID: newChangeID(), Title: title, Books: []int64{id}, Shelves: []string{shelf}, Remove: true, Time: time.Now()}
+}
+
+// change that adds a book to "read" or "currently-reading" and sets the
+// date it was finished or started to now.
+func datedChange(title string, id int64, shelf string) shelfChange {
+	return shelfChange{ID: newChangeID(), Title: title, Books: []int64{id}, Shelves: []string{shelf}, Dates: true, Time: time.Now()}
+}
+
+// String implements Stringer.
+func (c shelfChange) String() string {
+	if c.Dates {
+		verb := "Start"
+		if c.Shelves[0] == "read" {
+			verb = "Finish"
+		}
+		return fmt.Sprintf("%s “%s” on %s", verb, c.Title, c.Time.Format("2 Jan"))
+	}
+	if c.Remove {
+		return fmt.Sprintf("Remove “%s” from %s", c.Title, shelfTitles(c.Shelves))
+	}
+	return fmt.Sprintf("Add “%s” to %s", c.Title, shelfTitles(c.Shelves))
+}
+
+// make API request(s).
+func (c shelfChange) send() error {
+	if c.Dates {
+		for _, id := range c.Books {
+			var err error
+			if c.Shelves[0] == "read" {
+				err = api.FinishBook(opts.UserID, id, c.Time)
+			} else {
+				err = api.StartBook(opts.UserID, id, c.Time)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if !c.Remove {
+		return api.AddBooksToShelves(c.Books, c.Shelves)
+	}
+	for _, id := range c.Books {
+		for _, name := range c.Shelves {
+			if err := api.RemoveFromShelf(id, name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// update shelf index, cached shelves and shelf sizes as if change had
+// been sent. Books added to an exclusive shelf are removed from the others.
+func (c shelfChange) applyLocal() {
+	if !c.Remove {
+		var others []string
+		if excl := exclusiveShelves(); anyExclusive(excl, c.Shelves) {
+			for name := range excl {
+				if !contains(c.Shelves, name) {
+					others = append(others, name)
+				}
+			}
+		}
+		if len(others) > 0 {
+			shelfChange{Books: c.Books, Shelves: others, Remove: true}.applyLocal()
+		}
+	}
+
+	idx, err := loadShelfIndex()
+	if err != nil {
+		log.Printf("[queue] load shelf index: %v", err)
+		idx = shelfIndex{}
+	}
+
+	var books []gr.Book
+	if !c.Remove {
+		books = lookupBooks(c.Books)
+	}
+	for _, name := range c.Shelves {
+		// number of books actually added to or removed from shelf
+		var n int
+		for _, id := range c.Books {
+			if idx.has(id, name) == c.Remove {
+				n++
+			}
+			if c.Remove {
+				idx.remove(id, name)
+			} else {
+				idx.add(id, name)
+			}
+		}
+		if c.Remove {
+			logIfError(uncacheBooks(name, c.Books...), "uncache books: %v")
+			n = -n
+		} else {
+			logIfError(cacheBooks(name, books...), "cache books: %v")
+		}
+		logIfError(resizeShelf(name, n), "update shelf size: %v")
+	}
+	logIfError(wf.Cache.StoreJSON(shelfIndexKey, idx), "save shelf index: %v")
+}
+
+// changes that reverse c. The API can only remove one book from one shelf
+// at a time, so reversing an add may take several changes.
+func (c shelfChange) inverse() []shelfChange {
+	if c.Remove {
+		return []shelfChange{addChange(c.Title, c.Books, c.Shelves)}
+	}
+
+	var (
+		changes []shelfChange
+		titles  = map[int64]string{}
+	)
+	if len(c.Books) > 1 {
+		for _, b := range lookupBooks(c.Books) {
+			titles[b.ID] = b.Title
+		}
+	}
+	for _, id := range c.Books {
+		title := c.Title
+		if len(c.Books) > 1 {
+			if title = titles[id]; title == "" {
+				title = fmt.Sprintf("Book %d", id)
+			}
+		}
+		for _, name := range c.Shelves {
+			changes = append(changes, removeChange(title, id, name))
+		}
+	}
+	return changes
+}
+
+// record the shelves c's books are on. Must be called before c is applied.
+func (c *shelfChange) recordPrior() {
+	idx, err := loadShelfIndex()
+	if err != nil {
+		log.Printf("[queue] load shelf index: %v", err)
+		return
+	}
+	c.Prior = map[int64][]string{}
+	for _, id := range c.Books {
+		c.Prior[id] = append([]string{}, idx[id]...)
+	}
+}
+
+// undo applyLocal, returning c's books to the shelves they were on before
+// c was queued, so books that were already on a shelf stay on it.
+func (c shelfChange) revertLocal() {
+	if c.Prior == nil { // queued by an older version
+		for _, ic := range c.inverse() {
+			ic.applyLocal()
+		}
+		return
+	}
+
+	idx, err := loadShelfIndex()
+	if err != nil {
+		log.Printf("[queue] load shelf index: %v", err)
+		idx = shelfIndex{}
+	}
+	// applyLocal also changes the other exclusive shelves
+	names := append([]string{}, c.Shelves...)
+	if excl := exclusiveShelves(); anyExclusive(excl, c.Shelves) {
+		for name := range excl {
+			if !contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	for _, id := range c.Books {
+		var add []string
+		for _, name := range names {
+			was := contains(c.Prior[id], name)
+			switch {
+			case was && !idx.has(id, name):
+				add = append(add, name)
+			case !was && idx.has(id, name):
+				removeChange(c.Title, id, name).applyLocal()
+			}
+		}
+		if len(add) > 0 {
+			addChange(c.Title, []int64{id}, add).applyLocal()
+		}
+	}
+}
+
+// shelfQueue is the journal of changes waiting to be sent to Goodreads,
+// oldest first, and of changes Goodreads rejected.
+type shelfQueue struct {
+	Changes   []shelfChange
+	Conflicts []shelfChange
+}
+
+// load queue from data directory.
+func loadQueue() (*shelfQueue, error) {
+	q := &shelfQueue{}
+	if !wf.Data.Exists(queueKey) {
+		return q, nil
+	}
+	if err := wf.Data.LoadJSON(queueKey, q); err != nil {
+		return nil, errors.Wrap(err, "load shelf queue")
+	}
+	return q, nil
+}
+
+// Save queue to data directory. The file is deleted if the queue is
+// empty, so checking whether there are queued changes is cheap.
+func (q *shelfQueue) Save() error {
+	if len(q.Changes) == 0 && len(q.Conflicts) == 0 {
+		return wf.Data.Store(queueKey, nil)
+	}
+	return wf.Data.StoreJSON(queueKey, q)
+}
+
+// Take removes change with ID id from the queue and returns it.
+func (q *shelfQueue) Take(id int64) (c shelfChange, conflict, ok bool) {
+	if c, ok = takeChange(&q.Changes, id); ok {
+		return c, false, true
+	}
+	c, ok = takeChange(&q.Conflicts, id)
+	return c, true, ok
+}
+
+// remove change with ID id from changes.
+func takeChange(changes *[]shelfChange, id int64) (shelfChange, bool) {
+	for i, c := range *changes {
+		if c.ID == id {
+			*changes = append((*changes)[:i], (*changes)[i+1:]...)
+			return c, true
+		}
+	}
+	return shelfChange{}, false
+}
+
+// path of lock file for queue
+func queueLock() string { return filepath.Join(wf.DataDir(), queueKey+".lock") }
+
+// load queue, modify it and save it again. The replay job and the workflow
+// both write to the queue, so it's locked while it's updated.
+func updateQueue(fn func(q *shelfQueue)) error {
+	return withLock(queueLock(), func() error {
+		q, err := loadQueue()
+		if err != nil {
+			return err
+		}
+		fn(q)
+		return q.Save()
+	})
+}
+
+// make changes and record them in the undo log.
+func updateShelves(changes ...shelfChange) (queued bool, err error) {
+	undo := undoChanges(changes)
+	if queued, err = sendChanges(changes...); err != nil {
+		return
+	}
+	logIfError(pushUndo(changes, undo), "save undo log: %v")
+	return
+}
+
+// send changes to Goodreads. If the API can't be reached, or earlier changes
+// are still waiting to be sent, the remaining changes are queued and sent
+// by the replay job. Returns true if changes were queued. Changes are applied
+// to the cached shelves either way.
+//
+// The queue is locked throughout, so the replay job can't empty it between
+// checking for queued changes and queuing more.
+func sendChanges(changes ...shelfChange) (queued bool, err error) {
+	var n int // number of changes queued
+	err = withLock(queueLock(), func() error {
+		q, err := loadQueue()
+		if err != nil {
+			return err
+		}
+
+		offline := len(q.Changes) > 0 // send changes in order
+		for _, c := range changes {
+			if !offline {
+				log.Printf("[queue] %s ...", c)
+				err := c.send()
+				if err == nil {
+					c.applyLocal()
+					continue
+				}
+				if !gr.IsTemporary(err) {
+					return err
+				}
+				log.Printf("[queue] Goodreads unreachable, queuing changes: %v", err)
+				offline = true
+				c.Attempts, c.Error = 1, err.Error()
+			}
+			c.recordPrior()
+			c.applyLocal()
+			q.Changes = append(q.Changes, c)
+			n++
+		}
+		if n == 0 {
+			return nil
+		}
+		return q.Save()
+	})
+	if err != nil || n == 0 {
+		return false, err
+	}
+	log.Printf("[queue] queued %d change(s)", n)
+	return true, runJob(replayJob, "-replay")
+}
+
+// start replay job if any changes are waiting to be sent.
+func startReplay() error {
+	if wf.IsRunning(replayJob) || !wf.Data.Exists(queueKey) {
+		return nil
+	}
+	q, err := loadQueue()
+	if err != nil || len(q.Changes) == 0 {
+		return err
+	}
+	return runJob(replayJob, "-replay")
+}
+
+// send queued changes in order. Changes Goodreads rejects are moved to
+// the list of conflicts. If Goodreads is unreachable, the change is retried
+// with increasing delays. If it still fails, the job exits and is restarted
+// the next time the workflow is run.
+func runReplay() {
+	wf.Configure(aw.TextErrors(true))
+	if !opts.Authorised() {
+		return
+	}
+
+	var retries int
+	for {
+		q, err := loadQueue()
+		checkErr(err)
+		if len(q.Changes) == 0 {
+			log.Print("[queue] all changes sent")
+			return
+		}
+
+		c := q.Changes[0]
+		log.Printf("[queue] %s (%d queued) ...", c, len(q.Changes))
+		err = c.send()
+		switch {
+		case err == nil:
+			retries = 0
+			checkErr(updateQueue(func(q *shelfQueue) { q.Take(c.ID) }))
+
+		case gr.IsTemporary(err):
+			c.Attempts++
+			c.Error = err.Error()
+			checkErr(updateQueue(func(q *shelfQueue) {
+				for i, qc := range q.Changes {
+					if qc.ID == c.ID {
+						q.Changes[i] = c
+					}
+				}
+			}))
+			if retries >= maxRetries {
+				log.Printf("[queue] giving up after %d attempts: %v", c.Attempts, err)
+				return
+			}
+			delay := retryDelay << uint(retries)
+			retries++
+			log.Printf("[queue] retrying in %v: %v", delay, err)
+			time.Sleep(delay)
+
+		default:
+			log.Printf("[queue] conflict: %s: %v", c, err)
+			c.Attempts++
+			c.Error = err.Error()
+			c.revertLocal()
+			checkErr(updateQueue(func(q *shelfQueue) {
+				if _, _, ok := q.Take(c.ID); ok {
+					q.Conflicts = append(q.Conflicts, c)
+				}
+			}))
+		}
+	}
+}
+
+// add items for queued & failed changes to configuration.
+func addQueueItems() {
+	q, err := loadQueue()
+	if err != nil {
+		log.Printf("[queue] %v", err)
+		return
+	}
+
+	for _, c := range q.Changes {
+		sub := "Waiting to sync · queued " + c.Time.Format("2 Jan 15:04")
+		if c.Attempts > 0 {
+			sub += fmt.Sprintf(" · %d attempt(s) · %s", c.Attempts, c.Error)
+		}
+		queueItem(c, sub, "Retry now", iconReload)
+	}
+
+	for _, c := range q.Conflicts {
+		queueItem(c, "Failed: "+c.Error, "Try again", iconError)
+	}
+}
+
+// item for a queued or failed change.
+func queueItem(c shelfChange, subtitle, retry string, icon *aw.Icon) {
+	id := fmt.Sprintf("%d", c.ID)
+	it := wf.NewItem(c.String()).
+		Subtitle(subtitle+" · ↩ "+retry).
+		Arg("-retry", id).
+		Valid(true).
+		Icon(icon).
+		Var("action", "config").
+		Var("hide_alfred", "")
+
+	it.NewModifier(aw.ModCtrl).
+		Subtitle("Discard change").
+		Arg("-discard", id).
+		Icon(iconDelete).
+		Var("action", "config").
+		Var("hide_alfred", "")
+}
+
+// add a warning to shelf views if changes are waiting to be sent or failed.
+func addQueueStatus() {
+	if !opts.QueryEmpty() || !wf.Data.Exists(queueKey) {
+		return
+	}
+	q, err := loadQueue()
+	if err != nil {
+		log.Printf("[queue] %v", err)
+		return
+	}
+
+	var title string
+	icon := iconWarning
+	switch {
+	case len(q.Conflicts) > 0:
+		title = fmt.Sprintf("%d Shelf Change(s) Failed", len(q.Conflicts))
+		icon = iconError
+	case len(q.Changes) > 0:
+		title = fmt.Sprintf("%d Shelf Change(s) Waiting to Sync", len(q.Changes))
+	default:
+		return
+	}
+
+	wf.NewItem(title).
+		Subtitle("↩ to view in configuration").
+		Arg("-noop").
+		Valid(true).
+		Icon(icon).
+		Var("action", "config").
+		Var("query", "").
+		Var("hide_alfred", "")
+}
+
+// send a queued change now, or requeue a failed one. Without an ID, all
+// failed changes are requeued.
+func runRetry() {
+	wf.Configure(aw.TextErrors(true))
+
+	var id int64
+	if opts.Query != "" {
+		var err error
+		id, err = strconv.ParseInt(opts.Query, 10, 64)
+		checkErr(err)
+	}
+
+	var retried []shelfChange
+	err := updateQueue(func(q *shelfQueue) {
+		for _, c := range q.Conflicts {
+			if id == 0 || c.ID == id {
+				retried = append(retried, c)
+			}
+		}
+		for _, c := range retried {
+			q.Take(c.ID)
+			c.Error = ""
+			q.Changes = append(q.Changes, c)
+		}
+	})
+	if err != nil {
+		notifyError("Retry Failed", err, "config")
+		log.Fatalf("[ERROR] retry change: %v", err)
+	}
+	for _, c := range retried {
+		c.applyLocal()
+	}
+
+	log.Printf("[queue] requeued %d change(s)", len(retried))
+	checkErr(runJob(replayJob, "-replay"))
+	checkErr(notify("Syncing Shelf Changes", "Changes will be sent in the background", "config"))
+}
+
+// delete a queued or failed change.
+func runDiscard() {
+	wf.Configure(aw.TextErrors(true))
+
+	id, err := strconv.ParseInt(opts.Query, 10, 64)
+	checkErr(err)
+
+	var (
+		c               shelfChange
+		conflict, found bool
+	)
+	err = updateQueue(func(q *shelfQueue) { c, conflict, found = q.Take(id) })
+	if err != nil {
+		notifyError("Discard Failed", err, "config")
+		log.Fatalf("[ERROR] discard change: %v", err)
+	}
+	if !found {
+		checkErr(notify("Change Not Found", "It may already have been sent", "config"))
+		return
+	}
+	// failed changes have already been reverted
+	if !conflict {
+		c.revertLocal()
+	}
+
+	log.Printf("[queue] discarded: %s", c)
+	checkErr(notify("Change Discarded", c.String(), "config"))
+}
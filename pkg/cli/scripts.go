@@ -76,6 +76,7 @@ func runScript() {
 
 	scripts := LoadScripts()
 	if s, ok := scripts[opts.Query]; ok {
+		book := bookFromEnv()
 		if opts.ExportDetails { // add all book variables to environment
 			b, err := bookDetails(opts.BookID)
 			if err != nil {
@@ -85,7 +86,9 @@ func runScript() {
 			for k, v := range bookVariables(b) {
 				os.Setenv(k, v)
 			}
+			book = b
 		}
+		logIfError(recordHistory(book), "record history: %v")
 
 		log.Printf("[actions] running script %q ...", util.PrettyPath(s.Path))
 		data, err := util.RunCmd(runner.Cmd(s.Path))
@@ -165,23 +168,25 @@ func LoadScripts() map[string]Script {
 
 // returns Book populated with all details.
 func bookDetails(id int64) (gr.Book, error) {
-	key := "books/" + cachefileID(id)
-	reload := func() (interface{}, error) {
-		earliest := opts.LastRequestParsed.Add(time.Second * 1)
-		now := time.Now()
-		if earliest.After(now) {
-			d := earliest.Sub(now)
-			log.Printf("[throttled] waiting for %v ...", d)
-			time.Sleep(d)
-		}
-		data, _ := time.Now().MarshalText()
-		wf.Var("LAST_REQUEST", string(data))
-		return api.BookDetails(id)
+	if !caches.Books.Expired(id, opts.MaxCache.Default) {
+		return caches.Books.Load(id)
+	}
+
+	earliest := opts.LastRequestParsed.Add(time.Second * 1)
+	now := time.Now()
+	if earliest.After(now) {
+		d := earliest.Sub(now)
+		log.Printf("[throttled] waiting for %v ...", d)
+		time.Sleep(d)
 	}
+	data, _ := time.Now().MarshalText()
+	wf.Var("LAST_REQUEST", string(data))
 
-	var b gr.Book
-	util.MustExist(filepath.Dir(filepath.Join(wf.CacheDir(), key)))
-	if err := wf.Cache.LoadOrStoreJSON(key, opts.MaxCache.Default, reload, &b); err != nil {
+	b, err := api.BookDetails(id)
+	if err != nil {
+		return gr.Book{}, errors.Wrap(err, "book details")
+	}
+	if err := caches.Books.Save(id, b); err != nil {
 		return gr.Book{}, errors.Wrap(err, "book details")
 	}
 
@@ -189,8 +194,14 @@ func bookDetails(id int64) (gr.Book, error) {
 }
 
 func bookVariables(b gr.Book) map[string]string {
+	vars := b.Data()
+	// like Book.Data, omit the variable if there's no value
+	if p := coverPath(b.ID); p != "" {
+		vars["COVER_PATH"] = p
+	}
+
 	data := map[string]string{}
-	for k, v := range b.Data() {
+	for k, v := range vars {
 		data[k] = v
 		data[k+"_QUOTED"] = url.PathEscape(v)
 		data[k+"_QUOTED_PLUS"] = url.QueryEscape(v)
@@ -6,11 +6,9 @@ package cli
 import (
 	"fmt"
 	"log"
-	"path/filepath"
 	"time"
 
 	aw "github.com/deanishe/awgo"
-	"github.com/deanishe/awgo/util"
 
 	"go.deanishe.net/alfred-booksearch/pkg/gr"
 )
@@ -30,6 +28,13 @@ func runSearch() {
 	// Search for books
 	log.Printf("query=%q, sinceLastRequest=%v", opts.Query, time.Since(opts.LastRequestParsed))
 
+	// only free text is sent to Goodreads; filters are applied to the results
+	q := gr.ParseQuery(opts.Query)
+	if opts.QueryEmpty() {
+		showHistory()
+		return
+	}
+
 	if opts.QueryTooShort() {
 		wf.NewItem("Query Too Short").
 			Subtitle("Keep typing…")
@@ -41,11 +46,26 @@ func runSearch() {
 	wf.Var("last_query", opts.Query)
 
 	var (
-		icons = newIconCache(iconCacheDir)
-		books = cachingSearch(opts.Query)
-		mods  = LoadModifiers()
+		icons      = newIconCache(iconCacheDir)
+		books, err = cachingSearch(q.Text())
+		mods       = LoadModifiers()
 	)
 
+	// fall back to searching cached books if Goodreads is unavailable
+	if err != nil {
+		log.Printf("[search] %v", err)
+		var lib *library
+		if lib, err = loadLibrary(); err != nil {
+			wf.FatalError(err)
+		}
+		books = q.Apply(lib.Search(q.Text()))
+		wf.NewItem("Goodreads Unavailable").
+			Subtitle(fmt.Sprintf("Showing %d book(s) from your library", len(books))).
+			Icon(iconWarning)
+	} else {
+		books = q.Apply(books)
+	}
+
 	for _, b := range books {
 		bookItem(b, icons, mods)
 	}
@@ -66,40 +86,44 @@ func runSearch() {
 	wf.SendFeedback()
 }
 
-func cachingSearch(query string) (results []gr.Book) {
-	key := "queries/" + cachefile(hash(query), ".json")
-	reload := func() (interface{}, error) {
-		earliest := opts.LastRequestParsed.Add(time.Second * 1)
-		now := time.Now()
-		if earliest.After(now) {
-			d := earliest.Sub(now)
-			log.Printf("[throttled] waiting for %v ...", d)
-			time.Sleep(d)
-		}
-		data, _ := time.Now().MarshalText()
-		wf.Var("LAST_REQUEST", string(data))
-		return api.Search(query)
+func cachingSearch(query string) (results []gr.Book, err error) {
+	if !caches.Searches.Expired(query, opts.MaxCache.Search) {
+		return caches.Searches.Load(query)
 	}
 
-	util.MustExist(filepath.Dir(filepath.Join(wf.CacheDir(), key)))
-	if err := wf.Cache.LoadOrStoreJSON(key, opts.MaxCache.Search, reload, &results); err != nil {
-		panic(err)
+	earliest := opts.LastRequestParsed.Add(time.Second * 1)
+	now := time.Now()
+	if earliest.After(now) {
+		d := earliest.Sub(now)
+		log.Printf("[throttled] waiting for %v ...", d)
+		time.Sleep(d)
 	}
-	return
+	data, _ := time.Now().MarshalText()
+	wf.Var("LAST_REQUEST", string(data))
+
+	if results, err = api.Search(query); err != nil {
+		return nil, err
+	}
+	// results are still good if they can't be cached
+	logIfError(caches.Searches.Save(query, results), "cache search: %v")
+	return results, nil
 }
 
 // return an aw.Item for Book.
 func bookItem(b gr.Book, icons *iconCache, mods []Modifier) *aw.Item {
 	var date, subtitle, rating string
 
-	if !b.PubDate.IsZero() {
-		date = fmt.Sprintf(" (%s)", b.PubDate.Format("2006"))
+	if d, _ := b.Published(); !d.IsZero() {
+		date = fmt.Sprintf(" (%s)", d.Format("2006"))
 	}
 	if b.Rating != 0 {
 		rating = fmt.Sprintf(" ⭑ %0.2f", b.Rating)
 	}
 
 	subtitle = b.Author.Name + date + rating
+	if names := bookShelves(b.ID); len(names) > 0 {
+		subtitle += " · ✓ " + shelfTitles(names)
+	}
 
 	it := wf.NewItem(b.Title).
 		Subtitle(subtitle).
@@ -112,12 +136,17 @@ func bookItem(b gr.Book, icons *iconCache, mods []Modifier) *aw.Item {
 		Var("hide_alfred", "true").
 		Var("query", opts.Query).
 		Var("passvars", "true").
-		Var("action", "")
+		Var("action", "").
+		Var("BULK_ACTION", "")
 
 	if b.Description != "" {
 		it.Largetype(b.DescriptionText())
 	}
 
+	if p := coverPath(b.ID); p != "" {
+		it.Quicklook(p)
+	}
+
 	for k, v := range bookVariables(b) {
 		it.Var(k, v)
 	}
@@ -130,6 +159,22 @@ func bookItem(b gr.Book, icons *iconCache, mods []Modifier) *aw.Item {
 		Var("action", "scripts").
 		Var("query", "")
 
+	// user's ACTION_SHIFT takes precedence. Books can still be pinned
+	// with the "Pin Book" action.
+	if !modifierUsed(mods, aw.ModShift) {
+		pin := it.NewModifier(aw.ModShift)
+		if isPinned(b.ID) {
+			pin.Subtitle("Unpin Book").Arg("-unpin").Icon(iconDelete)
+		} else {
+			pin.Subtitle("Pin Book").Arg("-pin").Icon(iconSave)
+		}
+		if opts.FlagPinned { // return to pinned list
+			pin.Var("action", "pinned").
+				Var("query", opts.Query).
+				Var("passvars", "true")
+		}
+	}
+
 	for _, m := range mods {
 		it.NewModifier(m.Keys...).
 			Subtitle(m.Script.Name).
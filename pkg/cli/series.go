@@ -7,12 +7,11 @@ package cli
 import (
 	"fmt"
 	"log"
-	"path/filepath"
 	"strconv"
 
 	aw "github.com/deanishe/awgo"
-	"github.com/deanishe/awgo/util"
 	"go.deanishe.net/alfred-booksearch/pkg/gr"
+	"go.deanishe.net/alfred-booksearch/pkg/jobs"
 )
 
 // show books in a series
@@ -25,14 +24,8 @@ func runSeries() {
 	id := opts.SeriesID
 
 	if id == 0 {
-		var (
-			book gr.Book
-			key  = "books/" + cachefileID(opts.BookID)
-		)
-		if !wf.Cache.Exists(key) {
-			if !wf.IsRunning(bookJob) {
-				checkErr(runJob(bookJob, "-savebook"))
-			}
+		if !caches.Books.Exists(opts.BookID) {
+			checkErr(runAPIJob(jobs.Name(bookJob, opts.BookID), "-savebook", fmt.Sprintf("%d", opts.BookID)))
 			wf.Rerun(rerunInterval)
 			wf.NewItem("Loading Series…").
 				Subtitle("Results will appear momentarily").
@@ -41,7 +34,8 @@ func runSeries() {
 			return
 		}
 
-		checkErr(wf.Cache.LoadJSON(key, &book))
+		book, err := caches.Books.Load(opts.BookID)
+		checkErr(err)
 		id = book.Series.ID
 	}
 
@@ -55,20 +49,22 @@ func runSeries() {
 	wf.Var("SERIES_ID", fmt.Sprintf("%d", id))
 
 	var (
-		key    = "series/" + cachefileID(id)
 		icons  = newIconCache(iconCacheDir)
 		mods   = LoadModifiers()
 		series gr.Series
-		rerun  = wf.IsRunning(seriesJob)
+		job    = jobs.Name(seriesJob, id)
+		rerun  = wf.IsRunning(job)
 	)
 
-	if wf.Cache.Expired(key, opts.MaxCache.Default) {
+	if caches.Series.Expired(id, opts.MaxCache.Default) {
 		rerun = true
-		checkErr(runJob(seriesJob, "-saveseries", fmt.Sprintf("%d", id)))
+		checkErr(runAPIJob(job, "-saveseries", fmt.Sprintf("%d", id)))
 	}
 
-	if wf.Cache.Exists(key) {
-		checkErr(wf.Cache.LoadJSON(key, &series))
+	if caches.Series.Exists(id) {
+		var err error
+		series, err = caches.Series.Load(id)
+		checkErr(err)
 		log.Printf("loaded series %q from cache", series.Title)
 	} else {
 		wf.NewItem("Loading Series…").
@@ -78,18 +74,19 @@ func runSeries() {
 
 	log.Printf("[series] %d book(s) in series %q", len(series.Books), series.Title)
 
-	if opts.QueryEmpty() {
+	books, text := filterBooks(series.Books, opts.Query)
+	if text == "" {
 		wf.Configure(aw.SuppressUIDs(true))
 	}
 
-	for _, b := range series.Books {
+	for _, b := range books {
 		bookItem(b, icons, mods)
 	}
 
 	addNavActions()
 
-	if !opts.QueryEmpty() {
-		wf.Filter(opts.Query)
+	if text != "" {
+		wf.Filter(text)
 	}
 
 	wf.WarnEmpty("No Matching Books", "Try a different query?")
@@ -118,16 +115,18 @@ func runCacheSeries() {
 
 	var (
 		id, _  = strconv.ParseInt(opts.Query, 10, 64)
-		key    = "series/" + cachefileID(id)
 		series gr.Series
 		err    error
 	)
 
 	series, err = api.Series(id)
+	if err == gr.ErrNotModified {
+		log.Printf("[series] series %d unchanged", id)
+		err = nil
+	}
 	checkErr(err)
 
-	util.MustExist(filepath.Dir(filepath.Join(wf.CacheDir(), key)))
-	checkErr(wf.Cache.StoreJSON(key, series))
+	checkErr(caches.Series.Save(id, series))
 }
 
 func runCacheBook() {
@@ -135,6 +134,6 @@ func runCacheBook() {
 	if !opts.Authorised() {
 		return
 	}
-	_, err := bookDetails(opts.BookID)
+	_, err := bookDetails(argID(0))
 	checkErr(err)
 }
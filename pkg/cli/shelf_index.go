@@ -0,0 +1,171 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.deanishe.net/alfred-booksearch/pkg/gr"
+)
+
+const shelfIndexKey = "shelf_index.json"
+
+// reverse index of cached shelves, loaded by bookShelves
+var shelfIdx shelfIndex
+
+// shelfIndex maps book IDs to the names of the shelves they're on.
+type shelfIndex map[int64][]string
+
+// returns the names of the cached shelves book is on, in the order of
+// the user's list of shelves. The index is loaded on first call.
+func bookShelves(id int64) []string {
+	if shelfIdx == nil {
+		var err error
+		if shelfIdx, err = loadShelfIndex(); err != nil {
+			log.Printf("[shelves] load index: %v", err)
+			shelfIdx = shelfIndex{}
+		}
+	}
+	return shelfIdx[id]
+}
+
+// returns true if book is on shelf name.
+func onShelf(id int64, name string) bool {
+	bookShelves(id) // load index
+	return shelfIdx.has(id, name)
+}
+
+// load index from cache, rebuilding it if any cached shelf has changed.
+func loadShelfIndex() (shelfIndex, error) {
+	idx := shelfIndex{}
+	if wf.Cache.Exists(shelfIndexKey) {
+		age, err := wf.Cache.Age(shelfIndexKey)
+		if err != nil {
+			return nil, err
+		}
+		changed, err := shelvesChangedSince(time.Now().Add(-age))
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			return idx, wf.Cache.LoadJSON(shelfIndexKey, &idx)
+		}
+	}
+
+	if err := idx.build(); err != nil {
+		return nil, err
+	}
+	return idx, wf.Cache.StoreJSON(shelfIndexKey, idx)
+}
+
+// returns true if any cached shelf was modified after t.
+func shelvesChangedSince(t time.Time) (bool, error) {
+	var changed bool
+	err := filepath.Walk(caches.Shelves.Dir(), func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !fi.IsDir() && filepath.Ext(p) == ".json" && fi.ModTime().After(t) {
+			changed = true
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return changed, err
+}
+
+// index the shelves in the user's list of shelves. Each shelf is read from
+// whichever order is cached.
+func (idx shelfIndex) build() error {
+	if !caches.Shelves.ListExists() {
+		return nil
+	}
+	shelves, err := caches.Shelves.List()
+	if err != nil {
+		return err
+	}
+	for _, s := range shelves {
+		store, ok := cachedShelf(s.Name)
+		if !ok {
+			continue
+		}
+		shelf, err := store.Load(s.Name)
+		if err != nil {
+			return err
+		}
+		for _, b := range shelf.Books {
+			idx.add(b.ID, s.Name)
+		}
+	}
+	log.Printf("[shelves] indexed %d book(s) on %d shelves", len(idx), len(shelves))
+	return nil
+}
+
+func (idx shelfIndex) has(id int64, name string) bool {
+	for _, s := range idx[id] {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (idx shelfIndex) add(id int64, name string) {
+	if !idx.has(id, name) {
+		idx[id] = append(idx[id], name)
+	}
+}
+
+func (idx shelfIndex) remove(id int64, name string) {
+	var names []string
+	for _, s := range idx[id] {
+		if s != name {
+			names = append(names, s)
+		}
+	}
+	if len(names) == 0 {
+		delete(idx, id)
+		return
+	}
+	idx[id] = names
+}
+
+// titles of the shelves book is on, for display.
+func shelfTitles(names []string) string {
+	titles := make([]string, len(names))
+	for i, name := range names {
+		titles[i] = gr.Shelf{Name: name}.Title()
+	}
+	return strings.Join(titles, ", ")
+}
+
+// describe changes to a book's shelves, e.g. "Add to 2 shelves, remove from 1 shelf".
+func shelfChanges(add, remove string, nAdd, nRemove int) string {
+	var parts []string
+	if nAdd > 0 {
+		parts = append(parts, add+" "+countShelves(nAdd))
+	}
+	if nRemove > 0 {
+		parts = append(parts, remove+" "+countShelves(nRemove))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// e.g. "1 shelf" or "2 shelves".
+func countShelves(n int) string { return plural(n, "shelf", "shelves") }
+
+// e.g. "1 book" or "2 books".
+func countBooks(n int) string { return plural(n, "book", "books") }
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return "1 " + one
+	}
+	return fmt.Sprintf("%d %s", n, many)
+}
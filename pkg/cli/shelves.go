@@ -8,16 +8,18 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strings"
 	"time"
 
 	aw "github.com/deanishe/awgo"
+	"github.com/pkg/errors"
 
+	"go.deanishe.net/alfred-booksearch/pkg/cache"
 	"go.deanishe.net/alfred-booksearch/pkg/gr"
+	"go.deanishe.net/alfred-booksearch/pkg/jobs"
 	"go.deanishe.net/fuzzy"
 )
 
-const shelvesKey = "shelves.json"
-
 // Show books on shelf
 func runShelf() {
 	updateStatus()
@@ -27,17 +29,22 @@ func runShelf() {
 
 	var (
 		shelf gr.Shelf
-		key   = "shelves/" + opts.ShelfName + ".json"
-		rerun = wf.IsRunning(shelfJob)
+		order = shelfOrder()
+		store = caches.Shelves.Sorted(order)
+		job   = jobs.Name(shelfJob, opts.ShelfName, order)
+		rerun = wf.IsRunning(job)
 	)
+	log.Printf("[shelves] shelf=%q, order=%s", opts.ShelfName, order)
 
-	if wf.Cache.Expired(key, opts.MaxCache.Shelf) {
+	if store.Expired(opts.ShelfName, opts.MaxCache.Shelf) {
 		rerun = true
-		checkErr(runJob(shelfJob, "-saveshelf"))
+		checkErr(runAPIJob(job, shelfJobArgs(order)...))
 	}
 
-	if wf.Cache.Exists(key) {
-		checkErr(wf.Cache.LoadJSON(key, &shelf))
+	if store.Exists(opts.ShelfName) {
+		var err error
+		shelf, err = store.Load(opts.ShelfName)
+		checkErr(err)
 	} else {
 		wf.NewItem("Loading Books…").
 			Subtitle("Results will appear momentarily").
@@ -47,6 +54,7 @@ func runShelf() {
 	wf.Var("last_action", "shelf")
 	wf.Var("hide_alfred", "")
 	wf.Var("last_query", opts.Query)
+	wf.Var("SHELF_SORT", string(order))
 
 	var (
 		icons = newIconCache(iconCacheDir)
@@ -55,12 +63,16 @@ func runShelf() {
 
 	log.Printf("query=%q", opts.Query)
 
-	// show books in list order if there's no query
-	if opts.QueryEmpty() {
+	books, text := filterBooks(shelf.Books, opts.Query)
+
+	// show books in list order if there's no text for Alfred to sort by
+	if text == "" {
 		wf.Configure(aw.SuppressUIDs(true))
 	}
 
-	for _, b := range shelf.Books {
+	addBulkActions(shelf.Title())
+
+	for _, b := range books {
 		it := bookItem(b, icons, mods)
 
 		it.NewModifier(aw.ModCtrl).
@@ -70,10 +82,25 @@ func runShelf() {
 			Var("action", "shelf").
 			Var("query", opts.Query).
 			Var("passvars", "true")
+
+		sub := "Select for Bulk Action"
+		if bookSelected(b.ID) {
+			it.Icon(iconOK)
+			sub = "Deselect"
+		}
+		it.NewModifier(aw.ModCmd, aw.ModOpt).
+			Subtitle(sub).
+			Arg("-selectbook", fmt.Sprintf("%d", b.ID)).
+			Valid(true).
+			Icon(iconOK).
+			Var("action", "shelf").
+			Var("query", opts.Query).
+			Var("hide_alfred", "").
+			Var("passvars", "true")
 	}
 
 	// add alternate actions
-	if len(opts.Query) > 2 {
+	if len(text) > 2 {
 		wf.NewItem("Reload").
 			Subtitle("Reload shelf from server").
 			Arg("-reload").
@@ -96,8 +123,8 @@ func runShelf() {
 
 	addNavActions()
 
-	if !opts.QueryEmpty() {
-		wf.Filter(opts.Query)
+	if text != "" {
+		wf.Filter(text)
 	}
 
 	wf.WarnEmpty("No Matching Books", "Try a different query?")
@@ -141,13 +168,15 @@ func runShelves() {
 		rerun   = wf.IsRunning(shelvesJob)
 	)
 
-	if wf.Cache.Expired(shelvesKey, opts.MaxCache.Shelf) {
+	if caches.Shelves.ListExpired(opts.MaxCache.Shelf) {
 		rerun = true
-		checkErr(runJob(shelvesJob, "-saveshelves"))
+		checkErr(runAPIJob(shelvesJob, "-saveshelves"))
 	}
 
-	if wf.Cache.Exists(shelvesKey) {
-		checkErr(wf.Cache.LoadJSON(shelvesKey, &shelves))
+	if caches.Shelves.ListExists() {
+		var err error
+		shelves, err = caches.Shelves.List()
+		checkErr(err)
 		log.Printf("loaded %d shelves from cache", len(shelves))
 	} else {
 		wf.NewItem("Loading Shelves…").
@@ -159,16 +188,23 @@ func runShelves() {
 		wf.Configure(aw.SuppressUIDs(true))
 	}
 
+	addQueueStatus()
+	if opts.QueryEmpty() {
+		addUndoItem("shelves")
+	}
+
+	sorts := loadShelfSorts()
 	for _, shelf := range shelves {
 		id := fmt.Sprintf("%d", shelf.ID)
 		it := wf.NewItem(shelf.Title()).
-			Subtitle(fmt.Sprintf("%d book(s)", shelf.Size)).
+			Subtitle(fmt.Sprintf("%d book(s) · sorted by %s", shelf.Size, sorts[shelf.Name].Title())).
 			UID(id).
 			Valid(true).
 			Icon(iconShelf).
 			Var("SHELF_ID", id).
 			Var("SHELF_NAME", shelf.Name).
 			Var("SHELF_TITLE", shelf.Title()).
+			Var("SHELF_SORT", "").
 			Var("action", "shelf").
 			Var("passvars", "true")
 
@@ -178,6 +214,13 @@ func runShelves() {
 			Arg("-open", shelf.URL).
 			Var("action", "").
 			Var("hide_alfred", "true")
+
+		for _, m := range shelfSortMods {
+			it.NewModifier(m.keys...).
+				Subtitle("View sorted by "+m.order.Title()).
+				Valid(true).
+				Var("SHELF_SORT", string(m.order))
+		}
 	}
 
 	// add alternate actions
@@ -213,22 +256,35 @@ func runAddToShelves() {
 	if !opts.Authorised() {
 		return
 	}
-	log.Printf("adding book %d to shelves %v", opts.BookID, opts.Args)
-	if err := api.AddToShelves(opts.BookID, opts.Args); err != nil {
-		notifyError("Add to Shelves Failed", err)
-		log.Fatalf("[ERROR] add to shelf %q: %v", opts.Query, err)
+	var (
+		changes []shelfChange
+		removed []string
+	)
+	if opts.FlagDeselected != "" {
+		removed = strings.Split(opts.FlagDeselected, ",")
 	}
+	removals := exclusiveRemovals(opts.Args, removed)
 
-	msg := "Added to 1 shelf"
-	if len(opts.Args) > 1 {
-		msg = fmt.Sprintf("Added to %d shelves", len(opts.Args))
+	if len(opts.Args) > 0 {
+		log.Printf("adding book %d to shelves %v", opts.BookID, opts.Args)
+		changes = append(changes, addChange(opts.BookTitle, []int64{opts.BookID}, opts.Args))
+	}
+	for _, name := range removals {
+		log.Printf("removing book %d from shelf %q", opts.BookID, name)
+		changes = append(changes, removeChange(opts.BookTitle, opts.BookID, name))
 	}
+	queued, err := updateShelves(changes...)
+	if err != nil {
+		notifyError("Update Shelves Failed", err)
+		log.Fatalf("[ERROR] update shelves: %v", err)
+	}
+
 	v := aw.NewArgVars()
 	v.Var("notification_title", opts.BookTitle).
-		Var("notification_text", msg)
+		Var("notification_text", shelfChanges("Added to", "removed from", len(opts.Args), len(removals))+queuedNote(queued))
 
-	// deselect shelves
-	for _, s := range opts.Args {
+	// reset shelf selection
+	for _, s := range append(opts.Args, removed...) {
 		v.Var("shelf_"+s, "")
 	}
 	checkErr(v.Send())
@@ -241,7 +297,8 @@ func runRemoveFromShelf() {
 		return
 	}
 	log.Printf("removing book %d from shelf %q", opts.BookID, opts.Query)
-	if err := api.RemoveFromShelf(opts.BookID, opts.Query); err != nil {
+	queued, err := updateShelves(removeChange(opts.BookTitle, opts.BookID, opts.Query))
+	if err != nil {
 		notifyError("Remove from Shelf Failed", err)
 		log.Fatalf("[ERROR] remove from shelf %q: %v", opts.Query, err)
 	}
@@ -250,30 +307,144 @@ func runRemoveFromShelf() {
 	if title == "" {
 		title = opts.Query
 	}
-	notify(opts.BookTitle, fmt.Sprintf("Removed from “%s”", title))
+	checkErr(notify(opts.BookTitle, fmt.Sprintf("Removed from “%s”", title)+queuedNote(queued)))
+}
 
-	// remove book from cache
-	var (
-		key     = "shelves/" + opts.ShelfName + ".json"
-		cleaned []gr.Book
-		shelf   gr.Shelf
-	)
-	if !wf.Cache.Exists(key) {
+// add book to "read" or "currently-reading" and set the date it was
+// finished or started.
+func runReadingDates() {
+	wf.Configure(aw.TextErrors(true))
+	if !opts.Authorised() {
 		return
 	}
-	if err := wf.Cache.LoadJSON(key, &shelf); err != nil {
-		log.Fatalf("[ERROR] load cached shelf: %v", err)
+	shelf, verb := "currently-reading", "Started"
+	if opts.FlagFinish {
+		shelf, verb = "read", "Finished"
 	}
+	log.Printf("[shelves] %s book %d", strings.ToLower(verb), opts.BookID)
 
-	for _, b := range shelf.Books {
-		if b.ID != opts.BookID {
-			cleaned = append(cleaned, b)
+	c := datedChange(opts.BookTitle, opts.BookID, shelf)
+	queued, err := updateShelves(c)
+	if err != nil {
+		notifyError("Update Shelves Failed", err)
+		log.Fatalf("[ERROR] %s book: %v", strings.ToLower(verb), err)
+	}
+	checkErr(notify(opts.BookTitle, verb+" "+c.Time.Format("2 Jan 2006")+queuedNote(queued)))
+}
+
+// appended to notifications if changes were queued.
+func queuedNote(queued bool) string {
+	if queued {
+		return " · will sync when Goodreads is reachable"
+	}
+	return ""
+}
+
+// remove books from cached shelf in every order.
+func uncacheBooks(name string, ids ...int64) error {
+	remove := map[int64]bool{}
+	for _, id := range ids {
+		remove[id] = true
+	}
+	for _, order := range gr.ShelfSorts {
+		store := caches.Shelves.Sorted(order)
+		if !store.Exists(name) {
+			continue
+		}
+		shelf, err := store.Load(name)
+		if err != nil {
+			return err
+		}
+
+		var cleaned []gr.Book
+		for _, b := range shelf.Books {
+			if !remove[b.ID] {
+				cleaned = append(cleaned, b)
+			}
+		}
+		shelf.Books = cleaned
+		if err := store.Save(name, shelf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add books to cached shelf in every order. Books are added at the top
+// of shelves sorted by date added and at the end of other orders, where
+// they stay until the shelf is reloaded.
+func cacheBooks(name string, books ...gr.Book) error {
+	for _, order := range gr.ShelfSorts {
+		store := caches.Shelves.Sorted(order)
+		if !store.Exists(name) {
+			continue
+		}
+		shelf, err := store.Load(name)
+		if err != nil {
+			return err
+		}
+
+		seen := map[int64]bool{}
+		for _, b := range shelf.Books {
+			seen[b.ID] = true
+		}
+		var added []gr.Book
+		for _, b := range books {
+			if !seen[b.ID] {
+				added = append(added, b)
+			}
+		}
+		if order == gr.SortDateAdded {
+			shelf.Books = append(added, shelf.Books...)
+		} else {
+			shelf.Books = append(shelf.Books, added...)
+		}
+		if err := store.Save(name, shelf); err != nil {
+			return err
 		}
 	}
-	shelf.Books = cleaned
-	if err := wf.Cache.StoreJSON(key, shelf); err != nil {
-		log.Fatalf("[ERROR] cache shelf: %v", err)
+	return nil
+}
+
+// change the number of books on shelf name in the cached list of shelves.
+func resizeShelf(name string, delta int) error {
+	if delta == 0 || !caches.Shelves.ListExists() {
+		return nil
+	}
+	shelves, err := caches.Shelves.List()
+	if err != nil {
+		return err
+	}
+	for i, s := range shelves {
+		if s.Name == name {
+			if s.Size += delta; s.Size < 0 {
+				s.Size = 0
+			}
+			shelves[i] = s
+		}
 	}
+	return caches.Shelves.SaveList(shelves)
+}
+
+// look up books in the library, so they can be added to cached shelves.
+// Books that aren't in the library are omitted, except the current book,
+// which is built from the workflow variables.
+func lookupBooks(ids []int64) []gr.Book {
+	lib, err := loadLibrary()
+	if err != nil {
+		log.Printf("[shelves] %v", err)
+		lib = &library{}
+	}
+
+	var books []gr.Book
+	for _, id := range ids {
+		if e, ok := lib.Books[id]; ok {
+			books = append(books, e.Book)
+		} else if id == opts.BookID {
+			books = append(books, bookFromEnv())
+		}
+	}
+	return books
 }
 
 // update cached shelf
@@ -282,7 +453,8 @@ func runReloadShelf() {
 	if !opts.Authorised() {
 		return
 	}
-	checkErr(runJob(shelfJob, "-saveshelf"))
+	order := shelfOrder()
+	checkErr(runAPIJob(jobs.Name(shelfJob, opts.ShelfName, order), shelfJobArgs(order)...))
 }
 
 // update cached shelves
@@ -291,7 +463,7 @@ func runReloadShelves() {
 	if !opts.Authorised() {
 		return
 	}
-	checkErr(runJob(shelvesJob, "-saveshelves"))
+	checkErr(runAPIJob(shelvesJob, "-saveshelves"))
 }
 
 // choose shelves to add a book to
@@ -302,11 +474,20 @@ func runSelectShelves() {
 			key   = fmt.Sprintf("shelf_" + opts.Query)
 			value = "true"
 		)
-		if wf.Config.GetBool(key) {
+		if wf.Config.GetBool(key, onShelf(selectionBook(), opts.Query)) {
 			value = "false"
 		}
 		log.Printf("[shelves] shelf=%s, selected=%s", opts.Query, value)
-		checkErr(aw.NewArgVars().Var("shelf_"+opts.Query, value).Send())
+		v := aw.NewArgVars().Var("shelf_"+opts.Query, value)
+		// a book can only be on one exclusive shelf
+		if excl := exclusiveShelves(); value == "true" && excl[opts.Query] {
+			for name := range excl {
+				if name != opts.Query {
+					v.Var("shelf_"+name, "false")
+				}
+			}
+		}
+		checkErr(v.Send())
 		return
 	}
 
@@ -320,13 +501,15 @@ func runSelectShelves() {
 		rerun   = wf.IsRunning(shelvesJob)
 	)
 
-	if wf.Cache.Expired(shelvesKey, opts.MaxCache.Shelf) {
+	if caches.Shelves.ListExpired(opts.MaxCache.Shelf) {
 		rerun = true
-		checkErr(runJob(shelvesJob, "-saveshelves"))
+		checkErr(runAPIJob(shelvesJob, "-saveshelves"))
 	}
 
-	if wf.Cache.Exists(shelvesKey) {
-		checkErr(wf.Cache.LoadJSON(shelvesKey, &shelves))
+	if caches.Shelves.ListExists() {
+		var err error
+		shelves, err = caches.Shelves.List()
+		checkErr(err)
 		log.Printf("loaded %d shelves from cache", len(shelves))
 	} else {
 		wf.NewItem("Loading Shelves…").
@@ -337,15 +520,21 @@ func runSelectShelves() {
 	wf.Var("hide_alfred", "").Var("passvars", "true")
 
 	var (
-		selected   = selectShelves(shelves)
-		args       = append([]string{"-add"}, selected...)
-		lastAction = wf.Config.Get("last_action")
-		lastQuery  = wf.Config.Get("last_query")
-		msg        = "Add to 1 shelf"
+		added, removed = selectShelves(shelves, selectionBook())
+		args           = append([]string{"-add", "-deselected", strings.Join(removed, ",")}, added...)
+		lastAction     = wf.Config.Get("last_action")
+		lastQuery      = wf.Config.Get("last_query")
+		msg            = shelfChanges("Add to", "remove from", len(added), len(removed))
 	)
 
-	if len(selected) != 1 {
-		msg = fmt.Sprintf("Add to %d shelves", len(selected))
+	// choosing shelves to copy or move selected books to
+	if opts.BulkAction != "" && len(added) > 0 {
+		verb := "Copy"
+		if opts.BulkAction == bulkMove {
+			verb = "Move"
+		}
+		args = append([]string{"-bulk"}, added...)
+		msg = fmt.Sprintf("%s %s to %s", verb, countBooks(len(selectedBooks())), countShelves(len(added)))
 	}
 
 	if !opts.QueryEmpty() {
@@ -366,7 +555,7 @@ func runSelectShelves() {
 			Var("action", "select").
 			Var("query", "")
 
-		if len(selected) > 0 {
+		if msg != "" {
 			it.NewModifier(aw.ModCmd).
 				Subtitle(msg).
 				Valid(true).
@@ -388,15 +577,50 @@ func runSelectShelves() {
 	wf.SendFeedback()
 }
 
-// cache a specific shelf
+// cache a specific shelf. If possible, only changes since the shelf was
+// last cached are fetched.
 func runCacheShelf() {
 	wf.Configure(aw.TextErrors(true))
 	if !opts.Authorised() {
 		return
 	}
 
+	// shelf is passed as arguments, not in the environment (see argID)
+	if len(opts.Args) < 3 {
+		checkErr(errors.New("usage: -saveshelf <name> <order> <id>"))
+	}
+	opts.ShelfName, opts.ShelfID = opts.Args[0], argID(2)
+	order, err := gr.ParseShelfSort(opts.Args[1])
+	checkErr(err)
+
+	var (
+		store = caches.Shelves.Sorted(order)
+		syncs = loadShelfSyncs()
+		key   = syncKey(opts.ShelfName, order)
+		sync  = syncs[key]
+		now   = time.Now()
+	)
+
+	shelf, ok := syncShelf(store, order, sync)
+	if !ok {
+		shelf = fetchShelf(store, order)
+		sync.Full = now
+	}
+	checkErr(store.Save(opts.ShelfName, shelf))
+
+	sync.Synced = now
+	for _, b := range shelf.Books {
+		if b.DateUpdated.After(sync.Updated) {
+			sync.Updated = b.DateUpdated
+		}
+	}
+	syncs[key] = sync
+	logIfError(wf.Cache.StoreJSON(shelfSyncsKey, syncs), "save shelf sync times: %v")
+}
+
+// fetch all pages of a shelf.
+func fetchShelf(store cache.Shelves, order gr.ShelfSort) gr.Shelf {
 	var (
-		key       = "shelves/" + opts.ShelfName + ".json"
 		page      = 1
 		pageCount int
 		shelf     = gr.Shelf{ID: opts.ShelfID, Name: opts.ShelfName}
@@ -405,10 +629,10 @@ func runCacheShelf() {
 		last      time.Time
 		err       error
 
-		writePartial = !wf.Cache.Exists(key)
+		writePartial = !store.Exists(opts.ShelfName)
 	)
 
-	log.Printf("[shelves] fetching shelf %q ...", opts.ShelfName)
+	log.Printf("[shelves] fetching shelf %q (%s) ...", opts.ShelfName, order)
 
 	for {
 		if pageCount > 0 && page > pageCount {
@@ -422,7 +646,11 @@ func runCacheShelf() {
 		}
 		last = time.Now()
 
-		books, meta, err = api.UserShelf(opts.UserID, opts.ShelfName, page)
+		books, meta, err = api.UserShelf(opts.UserID, opts.ShelfName, page, order)
+		if err == gr.ErrNotModified {
+			log.Printf("[shelves] page %d unchanged", page)
+			err = nil
+		}
 		checkErr(err)
 
 		if pageCount == 0 {
@@ -435,13 +663,97 @@ func runCacheShelf() {
 		shelf.Books = append(shelf.Books, books...)
 		shelf.Size = meta.Total
 		if writePartial {
-			checkErr(wf.Cache.StoreJSON(key, shelf))
+			checkErr(store.Save(opts.ShelfName, shelf))
 		}
 		log.Printf("[shelves] cached page %d/%d, %d book(s)", page, pageCount, len(books))
 		page++
 	}
 
-	checkErr(wf.Cache.StoreJSON(key, shelf))
+	return shelf
+}
+
+// fetch books whose reviews changed since shelf was last synchronised,
+// newest first, and merge them into the cached shelf. Returns false if
+// the shelf must be fetched in full instead, e.g. because it isn't cached
+// or books have been removed from it.
+func syncShelf(store cache.Shelves, order gr.ShelfSort, sync shelfSync) (gr.Shelf, bool) {
+	if !store.Exists(opts.ShelfName) || sync.Updated.IsZero() || time.Since(sync.Full) > fullSyncInterval {
+		return gr.Shelf{}, false
+	}
+	shelf, err := store.Load(opts.ShelfName)
+	if err != nil {
+		log.Printf("[shelves] load shelf %q: %v", opts.ShelfName, err)
+		return gr.Shelf{}, false
+	}
+
+	log.Printf("[shelves] syncing shelf %q (%s) since %s ...", opts.ShelfName, order, sync.Updated.Format(time.RFC3339))
+
+	var (
+		changed []gr.Book
+		total   int
+	)
+	for page := 1; ; page++ {
+		books, meta, err := api.UserShelf(opts.UserID, opts.ShelfName, page, gr.SortDateUpdated)
+		if err == gr.ErrNotModified {
+			err = nil
+		}
+		checkErr(err)
+		total = meta.Total
+
+		var done bool
+		for _, b := range books {
+			if !b.DateUpdated.After(sync.Updated) {
+				done = true
+				break
+			}
+			changed = append(changed, b)
+		}
+		if done || len(books) == 0 || meta.End >= meta.Total {
+			break
+		}
+	}
+
+	books, ok := gr.MergeShelf(shelf.Books, changed, order)
+	if !ok || len(books) != total {
+		log.Printf("[shelves] %d changed book(s) can't be merged, fetching shelf %q in full", len(changed), opts.ShelfName)
+		return gr.Shelf{}, false
+	}
+	log.Printf("[shelves] merged %d changed book(s) into shelf %q", len(changed), opts.ShelfName)
+
+	shelf.Books, shelf.Size = books, total
+	return shelf, true
+}
+
+const (
+	// when each cached shelf was synchronised
+	shelfSyncsKey = "shelf_sync.json"
+	// how often shelves are fetched in full, to pick up changes to the
+	// books themselves, which don't change their reviews
+	fullSyncInterval = 24 * time.Hour
+)
+
+// shelfSync records when a cached shelf was synchronised.
+type shelfSync struct {
+	Synced  time.Time // last synchronisation
+	Full    time.Time // last time shelf was fetched in full
+	Updated time.Time // most recent review update on shelf
+}
+
+// key for shelf in a given order, which mirrors its path in the cache.
+func syncKey(name string, order gr.ShelfSort) string {
+	if order == gr.SortPosition {
+		return name
+	}
+	return string(order) + "/" + name
+}
+
+// load shelf sync times from cache.
+func loadShelfSyncs() map[string]shelfSync {
+	syncs := map[string]shelfSync{}
+	if wf.Cache.Exists(shelfSyncsKey) {
+		logIfError(wf.Cache.LoadJSON(shelfSyncsKey, &syncs), "load shelf sync times: %v")
+	}
+	return syncs
 }
 
 // cache list of user's shelves
@@ -457,7 +769,7 @@ func runCacheShelves() {
 		shelves, res []gr.Shelf
 		meta         gr.PageData
 		last         time.Time
-		writePartial = !wf.Cache.Exists(shelvesKey)
+		writePartial = !caches.Shelves.ListExists()
 		err          error
 	)
 
@@ -487,13 +799,13 @@ func runCacheShelves() {
 
 		shelves = append(shelves, res...)
 		if writePartial {
-			checkErr(wf.Cache.StoreJSON(shelvesKey, shelves))
+			checkErr(caches.Shelves.SaveList(shelves))
 		}
 		log.Printf("[shelves] cached page %d/%d, %d shelves", page, pageCount, len(shelves))
 		page++
 	}
 
-	checkErr(wf.Cache.StoreJSON(shelvesKey, shelves))
+	checkErr(caches.Shelves.SaveList(shelves))
 }
 
 // bySelection sorts shelves by selection status
@@ -531,15 +843,132 @@ func filterShelves(shelves []gr.Shelf, query string) []gr.Shelf {
 	return matches
 }
 
-func selectShelves(shelves []gr.Shelf) (names []string) {
+// returns ID of the book whose shelves are being edited, or 0 if shelves
+// are being chosen for a bulk action.
+func selectionBook() int64 {
+	if opts.BulkAction != "" {
+		return 0
+	}
+	return opts.BookID
+}
+
+// mark shelves selected and return the names of the shelves the book is
+// being added to and removed from. Shelves the book is already on are
+// selected unless the user has deselected them.
+func selectShelves(shelves []gr.Shelf, bookID int64) (added, removed []string) {
 	for i, s := range shelves {
-		s.Selected = wf.Config.GetBool("shelf_" + s.Name)
-		log.Printf("[shelves] name=%q, selected=%v", s.Name, s.Selected)
+		on := onShelf(bookID, s.Name)
+		s.Selected = wf.Config.GetBool("shelf_"+s.Name, on)
+		log.Printf("[shelves] name=%q, on=%v, selected=%v", s.Name, on, s.Selected)
 		shelves[i] = s
-		if s.Selected {
-			names = append(names, s.Name)
+		if s.Selected && !on {
+			added = append(added, s.Name)
+		}
+		if !s.Selected && on {
+			removed = append(removed, s.Name)
 		}
 	}
 	sort.Stable(bySelection(shelves))
 	return
 }
+
+// shelf orders are remembered per shelf in the data directory
+const shelfSortsKey = "shelf_sorts.json"
+
+// modifiers that open a shelf in a specific order
+var shelfSortMods = []struct {
+	keys  []aw.ModKey
+	order gr.ShelfSort
+}{
+	{[]aw.ModKey{aw.ModOpt}, gr.SortDateAdded},
+	{[]aw.ModKey{aw.ModCtrl}, gr.SortDateRead},
+	{[]aw.ModKey{aw.ModShift}, gr.SortRating},
+	{[]aw.ModKey{aw.ModFn}, gr.SortTitle},
+	{[]aw.ModKey{aw.ModCtrl, aw.ModOpt}, gr.SortAuthor},
+	{[]aw.ModKey{aw.ModCtrl, aw.ModShift}, gr.SortPosition},
+}
+
+// returns the store containing shelf name in any order, preferring the
+// shelf's own order.
+func cachedShelf(name string) (cache.Shelves, bool) {
+	for _, order := range gr.ShelfSorts {
+		if store := caches.Shelves.Sorted(order); store.Exists(name) {
+			return store, true
+		}
+	}
+	return cache.Shelves{}, false
+}
+
+// names of the user's exclusive shelves.
+func exclusiveShelves() map[string]bool {
+	excl := map[string]bool{}
+	if caches.Shelves.ListExists() {
+		shelves, err := caches.Shelves.List()
+		logIfError(err, "load shelves: %v")
+		for _, s := range shelves {
+			if s.Exclusive {
+				excl[s.Name] = true
+			}
+		}
+	}
+	return excl
+}
+
+// returns true if any of names is in excl.
+func anyExclusive(excl map[string]bool, names []string) bool {
+	for _, name := range names {
+		if excl[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// returns removed without exclusive shelves if any of added is exclusive.
+// Goodreads moves a book from one exclusive shelf to another, and removing
+// a book from an exclusive shelf removes it from all the user's shelves.
+func exclusiveRemovals(added, removed []string) []string {
+	excl := exclusiveShelves()
+	if !anyExclusive(excl, added) {
+		return removed
+	}
+	var names []string
+	for _, name := range removed {
+		if !excl[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// load the order each shelf was last viewed in.
+func loadShelfSorts() map[string]gr.ShelfSort {
+	sorts := map[string]gr.ShelfSort{}
+	if wf.Data.Exists(shelfSortsKey) {
+		logIfError(wf.Data.LoadJSON(shelfSortsKey, &sorts), "load shelf orders: %v")
+	}
+	return sorts
+}
+
+// returns the order to show opts.ShelfName in. An order chosen with a
+// modifier (SHELF_SORT) is remembered for the shelf; otherwise the shelf's
+// remembered order is used.
+func shelfOrder() gr.ShelfSort {
+	sorts := loadShelfSorts()
+	order, err := gr.ParseShelfSort(opts.ShelfSort)
+	if err != nil {
+		log.Printf("[shelves] %v", err)
+	}
+	if opts.ShelfSort == "" || err != nil {
+		if order = sorts[opts.ShelfName]; order == "" {
+			order = gr.SortPosition
+		}
+		return order
+	}
+
+	if sorts[opts.ShelfName] != order {
+		sorts[opts.ShelfName] = order
+		logIfError(wf.Data.StoreJSON(shelfSortsKey, sorts), "save shelf orders: %v")
+	}
+	return order
+}
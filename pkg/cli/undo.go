@@ -0,0 +1,188 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package cli
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"time"
+
+	aw "github.com/deanishe/awgo"
+	"github.com/pkg/errors"
+)
+
+const (
+	// undo log is kept in the data directory with the queue
+	undoKey = "undo.json"
+	// how many changes to remember
+	maxUndo = 10
+)
+
+// undoEntry is a change to the user's shelves that can be undone.
+type undoEntry struct {
+	Description string        // what the user did
+	Changes     []shelfChange // changes that reverse it
+	Time        time.Time
+}
+
+// load undo log from data directory, newest entry first.
+func loadUndo() ([]undoEntry, error) {
+	var entries []undoEntry
+	if !wf.Data.Exists(undoKey) {
+		return entries, nil
+	}
+	if err := wf.Data.LoadJSON(undoKey, &entries); err != nil {
+		return nil, errors.Wrap(err, "load undo log")
+	}
+	return entries, nil
+}
+
+// changes that reverse changes, ignoring books that are already on
+// (or not on) the shelves they're added to (or removed from), so undoing
+// doesn't remove books from shelves they were already on. Must be called
+// before changes are applied.
+//
+// Removing a book from an exclusive shelf removes it from every shelf, so
+// a book moved from one exclusive shelf to another is moved back instead.
+func undoChanges(changes []shelfChange) []shelfChange {
+	idx, err := loadShelfIndex()
+	if err != nil {
+		log.Printf("[undo] load shelf index: %v", err)
+		idx = shelfIndex{}
+	}
+
+	var (
+		excl = exclusiveShelves()
+		undo []shelfChange
+	)
+	for i := len(changes) - 1; i >= 0; i-- {
+		for _, c := range changes[i].inverse() {
+			id, name := c.Books[0], c.Shelves[0]
+			if idx.has(id, name) == c.Remove {
+				continue
+			}
+			if c.Remove && excl[name] {
+				for _, s := range idx[id] {
+					if excl[s] {
+						c = addChange(c.Title, c.Books, []string{s})
+						break
+					}
+				}
+			}
+			undo = append(undo, c)
+		}
+	}
+	return undo
+}
+
+// load undo log, modify it and save it again. The log is locked while it's
+// updated, as changes may be made while an undo is being sent.
+func updateUndo(fn func(entries []undoEntry) ([]undoEntry, error)) error {
+	return withLock(filepath.Join(wf.DataDir(), undoKey+".lock"), func() error {
+		entries, err := loadUndo()
+		if err != nil {
+			return err
+		}
+		if entries, err = fn(entries); err != nil {
+			return err
+		}
+		return wf.Data.StoreJSON(undoKey, entries)
+	})
+}
+
+// add changes and the changes that reverse them to the undo log.
+func pushUndo(changes, undo []shelfChange) error {
+	if len(undo) == 0 {
+		return nil
+	}
+	desc := changes[0].String()
+	if len(changes) > 1 {
+		desc += fmt.Sprintf(" and %d more", len(changes)-1)
+	}
+	return updateUndo(func(entries []undoEntry) ([]undoEntry, error) {
+		entries = append([]undoEntry{{Description: desc, Changes: undo, Time: time.Now()}}, entries...)
+		if len(entries) > maxUndo {
+			entries = entries[:maxUndo]
+		}
+		return entries, nil
+	})
+}
+
+// add "Undo Last Change" item. action is the view to return to.
+func addUndoItem(action string) {
+	if !wf.Data.Exists(undoKey) {
+		return
+	}
+	entries, err := loadUndo()
+	if err != nil {
+		log.Printf("[undo] %v", err)
+		return
+	}
+	if len(entries) == 0 {
+		return
+	}
+
+	e := entries[0]
+	wf.NewItem("Undo Last Change").
+		Subtitle(fmt.Sprintf("%s · %s", e.Description, e.Time.Format("2 Jan 15:04"))).
+		Arg("-undo").
+		UID("undo").
+		Valid(true).
+		Icon(iconReload).
+		Var("action", action).
+		Var("query", "").
+		Var("hide_alfred", "")
+}
+
+// reverse the most recent change in the undo log via the API.
+func runUndo() {
+	wf.Configure(aw.TextErrors(true))
+	if !opts.Authorised() {
+		return
+	}
+
+	var (
+		e       undoEntry
+		found   bool
+		queued  bool
+		undoErr error
+	)
+	// the log stays locked while the changes are sent, so the entry can't
+	// be undone twice
+	err := updateUndo(func(entries []undoEntry) ([]undoEntry, error) {
+		if len(entries) == 0 {
+			return entries, nil
+		}
+		e, found = entries[0], true
+		log.Printf("[undo] %s (%d change(s)) ...", e.Description, len(e.Changes))
+		// send changes one at a time, so if one fails, the entry can be
+		// trimmed to the changes that haven't been made
+		for i, c := range e.Changes {
+			var q bool
+			if q, undoErr = sendChanges(c); undoErr != nil {
+				if i > 0 {
+					undoErr = errors.Wrapf(undoErr, "%d of %d changes undone", i, len(e.Changes))
+				}
+				entries[0].Changes = e.Changes[i:]
+				return entries, nil
+			}
+			queued = queued || q
+		}
+		return entries[1:], nil
+	})
+	if undoErr != nil {
+		notifyError("Undo Failed", undoErr)
+		log.Fatalf("[ERROR] undo %q: %v", e.Description, undoErr)
+	}
+	if err != nil {
+		log.Fatalf("[ERROR] save undo log: %v", err)
+	}
+	if !found {
+		checkErr(notify("Nothing to Undo", ""))
+		return
+	}
+
+	checkErr(notify("Undone", e.Description+queuedNote(queued)))
+}
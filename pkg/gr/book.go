@@ -9,7 +9,6 @@ import (
 	"log"
 	"net/url"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
@@ -36,16 +35,91 @@ type Book struct {
 	ISBN   string // not in search results
 	ISBN13 string // not in search results
 
-	Title         string
-	TitleNoSeries string
-	Series        Series
-	Author        Author
-	PubDate       date.Date
-	Rating        float64 // average rating or user rating (RSS feeds)
-	Description   string  // HTML, not in search results
+	Title                   string
+	TitleNoSeries           string
+	Series                  Series   // primary series
+	OtherSeries             []Series // any further series the book belongs to
+	Author                  Author
+	PubDate                 date.Date     // original publication date, not in shelves or author lists
+	PubDatePrecision        DatePrecision // how much of PubDate is known
+	EditionPubDate          date.Date     // publication date of this edition, not in search results or series
+	EditionPubDatePrecision DatePrecision // how much of EditionPubDate is known
+	Rating                  float64       // average rating or user rating (RSS feeds)
+	Description             string        // HTML, not in search results
 
 	URL      string // Book's page on goodreads.com
 	ImageURL string // URL of cover image
+
+	// When book was added to shelf and when user last changed its review
+	// (shelf, rating, read dates, etc.). Only in shelves.
+	DateAdded   time.Time
+	DateUpdated time.Time
+}
+
+// DatePrecision is how much of a publication date is known. Goodreads
+// often only has the year or month, in which case the missing parts of
+// the date are set to 1.
+type DatePrecision int
+
+// Publication date precisions.
+const (
+	PrecisionNone  DatePrecision = iota // no date
+	PrecisionYear                       // year only
+	PrecisionMonth                      // year and month
+	PrecisionDay                        // full date
+)
+
+var precisionNames = []string{"", "year", "month", "day"}
+
+// String returns "year", "month", "day" or "" if date is unknown.
+func (p DatePrecision) String() string {
+	if p < PrecisionNone || p > PrecisionDay {
+		return ""
+	}
+	return precisionNames[p]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p DatePrecision) MarshalText() ([]byte, error) { return []byte(p.String()), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *DatePrecision) UnmarshalText(data []byte) error {
+	for i, s := range precisionNames {
+		if s == string(data) {
+			*p = DatePrecision(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid date precision: %q", string(data))
+}
+
+// create a publication date from its (possibly zero) parts.
+func newPubDate(year, month, day int) (date.Date, DatePrecision) {
+	switch {
+	case year == 0:
+		return date.Date{}, PrecisionNone
+	case month == 0:
+		return date.New(year, time.January, 1), PrecisionYear
+	case day == 0:
+		return date.New(year, time.Month(month), 1), PrecisionMonth
+	default:
+		return date.New(year, time.Month(month), day), PrecisionDay
+	}
+}
+
+// Published returns the original publication date if known, otherwise
+// the publication date of the edition.
+func (b Book) Published() (date.Date, DatePrecision) {
+	if !b.PubDate.IsZero() {
+		return b.PubDate, b.PubDatePrecision
+	}
+	return b.EditionPubDate, b.EditionPubDatePrecision
+}
+
+// HasCover returns true if Book has a cover image. Books without a cover
+// have no image URL or one pointing to Goodreads' "nophoto" placeholder.
+func (b Book) HasCover() bool {
+	return b.ImageURL != "" && !strings.Contains(b.ImageURL, "/nophoto/")
 }
 
 // HasSeries returns true if Book belongs to a series.
@@ -78,12 +152,27 @@ func (b Book) Data() map[string]string {
 		"AUTHOR":               b.Author.Name,
 		"AUTHOR_ID":            fmt.Sprintf("%d", b.Author.ID),
 		"AUTHOR_URL":           b.Author.URL,
-		"YEAR":                 b.PubDate.Format("2006"),
 		"RATING":               fmt.Sprintf("%f", b.Rating),
 		"BOOK_URL":             b.URL,
 		"IMAGE_URL":            b.ImageURL,
 	}
 
+	addDate := func(prefix string, d date.Date, p DatePrecision) {
+		if d.IsZero() {
+			return
+		}
+		data[prefix+"YEAR"] = d.Format("2006")
+		if p >= PrecisionMonth {
+			data[prefix+"PUB_MONTH"] = d.Format("2006-01")
+		}
+		if p == PrecisionDay {
+			data[prefix+"PUB_DATE"] = d.Format("2006-01-02")
+		}
+	}
+	d, p := b.Published()
+	addDate("", d, p)
+	addDate("EDITION_", b.EditionPubDate, b.EditionPubDatePrecision)
+
 	// remove empty/unset variabels
 	out := map[string]string{}
 	for k, v := range data {
@@ -145,17 +234,22 @@ func unmarshalBookDetails(data []byte) (Book, error) {
 			ISBN   string `xml:"isbn"`
 			ISBN13 string `xml:"isbn13"`
 
-			Title          string   `xml:"title"`
-			TitleNoSeries  string   `xml:"work>original_title"`
-			SeriesName     string   `xml:"series_works>series_work>series>title"`
-			SeriesPosition float64  `xml:"series_works>series_work>user_position"`
-			SeriesID       int64    `xml:"series_works>series_work>series>id"`
-			Authors        []Author `xml:"authors>author"`
-			Year           int      `xml:"work>original_publication_year"`
-			Month          int      `xml:"work>original_publication_month"`
-			Day            int      `xml:"work>original_publication_day"`
-			Rating         float64  `xml:"average_rating"`
-			Description    string   `xml:"description"`
+			Title         string `xml:"title"`
+			TitleNoSeries string `xml:"work>original_title"`
+			Series        []struct {
+				Title    string `xml:"series>title"`
+				ID       int64  `xml:"series>id"`
+				Position string `xml:"user_position"`
+			} `xml:"series_works>series_work"`
+			Authors     []Author `xml:"authors>author"`
+			Year        int      `xml:"work>original_publication_year"`
+			Month       int      `xml:"work>original_publication_month"`
+			Day         int      `xml:"work>original_publication_day"`
+			EdYear      int      `xml:"publication_year"`
+			EdMonth     int      `xml:"publication_month"`
+			EdDay       int      `xml:"publication_day"`
+			Rating      float64  `xml:"average_rating"`
+			Description string   `xml:"description"`
 
 			ImageURL string `xml:"image_url"`
 		} `xml:"book"`
@@ -178,31 +272,27 @@ func unmarshalBookDetails(data []byte) (Book, error) {
 		ISBN13:        v.Book.ISBN13,
 		Title:         v.Book.Title,
 		TitleNoSeries: title,
-		Series: Series{
-			Title:    strings.TrimSpace(v.Book.SeriesName),
-			ID:       v.Book.SeriesID,
-			Position: v.Book.SeriesPosition,
-		},
-		Rating:      v.Book.Rating,
-		URL:         fmt.Sprintf("https://www.goodreads.com/book/show/%d", v.Book.ID),
-		Description: v.Book.Description,
-		ImageURL:    v.Book.ImageURL,
+		Rating:        v.Book.Rating,
+		URL:           fmt.Sprintf("https://www.goodreads.com/book/show/%d", v.Book.ID),
+		Description:   v.Book.Description,
+		ImageURL:      v.Book.ImageURL,
+	}
+
+	var series []Series
+	for _, r := range v.Book.Series {
+		s := Series{Title: strings.TrimSpace(r.Title), ID: r.ID}
+		s.setPosition(r.Position)
+		series = append(series, s)
 	}
+	b.Series, b.OtherSeries = splitSeries(series)
 
 	if len(v.Book.Authors) > 0 {
 		b.Author = v.Book.Authors[0]
 		b.Author.URL = fmt.Sprintf("https://www.goodreads.com/author/show/%d", b.Author.ID)
 	}
 
-	if v.Book.Month == 0 {
-		v.Book.Month = 1
-	}
-	if v.Book.Day == 0 {
-		v.Book.Day = 1
-	}
-	if v.Book.Year != 0 {
-		b.PubDate = date.New(v.Book.Year, time.Month(v.Book.Month), v.Book.Day)
-	}
+	b.PubDate, b.PubDatePrecision = newPubDate(v.Book.Year, v.Book.Month, v.Book.Day)
+	b.EditionPubDate, b.EditionPubDatePrecision = newPubDate(v.Book.EdYear, v.Book.EdMonth, v.Book.EdDay)
 
 	return b, nil
 }
@@ -240,22 +330,13 @@ func unmarshalSearchResults(data []byte) (books []Book, err error) {
 			WorkID:        r.WorkID,
 			Title:         r.Title,
 			TitleNoSeries: title,
-			Series:        series,
 			Author:        r.Author,
 			Rating:        r.Rating,
 			URL:           fmt.Sprintf("https://www.goodreads.com/book/show/%d", r.ID),
 			ImageURL:      r.ImageURL,
 		}
-		if r.Month == 0 {
-			r.Month = 1
-		}
-		if r.Day == 0 {
-			r.Day = 1
-		}
-
-		if r.Year != 0 {
-			b.PubDate = date.New(r.Year, time.Month(r.Month), r.Day)
-		}
+		b.Series, b.OtherSeries = splitSeries(series)
+		b.PubDate, b.PubDatePrecision = newPubDate(r.Year, r.Month, r.Day)
 		b.Author.URL = fmt.Sprintf("https://www.goodreads.com/author/show/%d", b.Author.ID)
 		books = append(books, b)
 	}
@@ -342,55 +423,68 @@ func unmarshalAuthorBooks(data []byte) (books []Book, meta PageData, err error)
 			ISBN:          r.ISBN,
 			ISBN13:        r.ISBN13,
 			Title:         r.Title,
-			Series:        series,
 			Description:   r.Description,
 			TitleNoSeries: r.TitleNoSeries,
 			Rating:        r.Rating,
 			URL:           fmt.Sprintf("https://www.goodreads.com/book/show/%d", r.ID),
 			ImageURL:      r.ImageURL,
 		}
+		b.Series, b.OtherSeries = splitSeries(series)
 
 		if len(r.Authors) > 0 {
 			b.Author = r.Authors[0]
 			b.Author.URL = fmt.Sprintf("https://www.goodreads.com/author/show/%d", b.Author.ID)
 		}
 
-		if r.Month == 0 {
-			r.Month = 1
-		}
-		if r.Day == 0 {
-			r.Day = 1
-		}
-
-		if r.Year != 0 {
-			b.PubDate = date.New(r.Year, time.Month(r.Month), r.Day)
-		}
+		b.EditionPubDate, b.EditionPubDatePrecision = newPubDate(r.Year, r.Month, r.Day)
 		books = append(books, b)
 	}
 
 	return
 }
 
-// regexes to match book titles with embedded series info
 var (
-	seriesRegexes = []*regexp.Regexp{
-		regexp.MustCompile(`^(.+)\s\((.+?),?\s+#([0-9.]+)\)$`),
-		regexp.MustCompile(`^(.+)\s\((.+?) Series Book ([0-9.]+)\)$`),
-	}
+	// book title with trailing parenthesised series info, e.g. "Storm Front (The Dresden Files, #1)"
+	rxTitleSeries = regexp.MustCompile(`^(.+)\s\(([^()]+)\)$`)
+	// a single series in the series info, e.g. "Discworld, #1", "Undying Mercenaries Series Book 13",
+	// "Perry Rhodan, Band 1" or "Discworld, #1-3"
+	rxSeries = regexp.MustCompile(`(?i)^(.+?),?\s+(?:#\s*|(?:Series\s+)?(?:Book|Vol\.?|Volume|Band|Tome|Tomo|Teil|Livre|Libro|Deel)\s+)` +
+		`([0-9][0-9.]*[a-z]?(?:\s*[-–]\s*[0-9][0-9.]*[a-z]?)?)$`)
 )
 
 // extract title & series from book title with embedded series info.
-func parseTitle(s string) (title string, series Series) {
-	for _, rx := range seriesRegexes {
-		values := rx.FindAllStringSubmatch(s, -1)
-		if len(values) == 1 {
-			title = strings.TrimSpace(values[0][1])
-			series.Title = strings.TrimSpace(values[0][2])
-			series.Position, _ = strconv.ParseFloat(values[0][3], 64)
-			return
+// Multiple series are separated by semicolons, e.g. "(Discworld, #1; Rincewind #1)".
+// If the series info can't be parsed, the whole string is returned as the title.
+func parseTitle(s string) (title string, series []Series) {
+	m := rxTitleSeries.FindStringSubmatch(s)
+	if m == nil {
+		return s, nil
+	}
+
+	for _, part := range strings.Split(m[2], ";") {
+		values := rxSeries.FindStringSubmatch(strings.TrimSpace(part))
+		if values == nil {
+			continue
 		}
+		sr := Series{Title: strings.TrimSpace(values[1])}
+		sr.setPosition(values[2])
+		series = append(series, sr)
 	}
 
-	title = s
+	if len(series) == 0 {
+		return s, nil
+	}
+
+	return strings.TrimSpace(m[1]), series
+}
+
+// split series into primary series and the rest.
+func splitSeries(series []Series) (primary Series, others []Series) {
+	if len(series) > 0 {
+		primary = series[0]
+	}
+	if len(series) > 1 {
+		others = series[1:]
+	}
 	return
 }
@@ -36,7 +36,8 @@ func parseFeedURL(URL string) (userID, feedKey string, err error) {
 	return
 }
 
-// FetchFeed retrieves and parses a Goodreads RSS feed.
+// FetchFeed retrieves and parses a Goodreads RSS feed. If Client has a Cache
+// and the feed is unchanged, the cached feed is returned with ErrNotModified.
 func (c *Client) FetchFeed(userID int64, shelf string) (Feed, error) {
 	var (
 		data []byte
@@ -48,11 +49,17 @@ func (c *Client) FetchFeed(userID int64, shelf string) (Feed, error) {
 	v.Set("shelf", shelf)
 	u.RawQuery = v.Encode()
 
-	if data, err = c.httpGet(u.String()); err != nil {
+	if data, err = c.cachedGet(u.String()); err != nil && err != ErrNotModified {
 		return Feed{}, errors.Wrap(err, "retrive feed")
 	}
 
-	return unmarshalFeed(data)
+	notModified := err == ErrNotModified
+
+	feed, err := unmarshalFeed(data)
+	if err == nil && notModified {
+		err = ErrNotModified
+	}
+	return feed, err
 }
 
 // Parse RSS feed data.
@@ -42,6 +42,8 @@ type Client struct {
 	APISecret string     // Goodreads API secret
 	Store     TokenStore // Persistent store for access tokens
 	Log       Logger     // Library logger
+	Cache     HTTPCache  // Optional store for conditional requests
+	Limiter   Limiter    // Optional rate limiter shared with other clients
 
 	token       *oauth.AccessToken
 	apiClient   *http.Client
@@ -35,13 +35,31 @@ func init() {
 	userAgent = "Alfred Booksearch Workflow " + version + " (+https://github.com/deanishe/alfred-booksearch)"
 }
 
-// retrieve URL with standard HTTP client.
-func (c *Client) httpGet(URL string) ([]byte, error) {
-	return c.httpRequest(URL, httpClient)
+// retrieve URL with standard HTTP client, making a conditional request
+// if response is cached. Returns cached data and ErrNotModified if
+// resource is unchanged.
+func (c *Client) cachedGet(URL string) ([]byte, error) {
+	return c.httpRequest(URL, httpClient, "GET", true)
 }
 
 // retrieve URL with authorised API client.
 func (c *Client) apiRequest(URL string, method ...string) ([]byte, error) {
+	meth := "GET"
+	if len(method) > 0 {
+		meth = method[0]
+	}
+	return c.authedRequest(URL, meth, false)
+}
+
+// retrieve URL with authorised API client, making a conditional request
+// if response is cached. Returns cached data and ErrNotModified if
+// resource is unchanged.
+func (c *Client) cachedAPIRequest(URL string) ([]byte, error) {
+	return c.authedRequest(URL, "GET", true)
+}
+
+// retrieve URL with authorised API client, respecting rate limit.
+func (c *Client) authedRequest(URL, method string, conditional bool) ([]byte, error) {
 	var (
 		client *http.Client
 		data   []byte
@@ -57,51 +75,95 @@ func (c *Client) apiRequest(URL string, method ...string) ([]byte, error) {
 		c.Log.Printf("[api] pausing %v until next request ...", d)
 		time.Sleep(d)
 	}
-
-	if data, err = c.httpRequest(URL, client, method...); err != nil {
-		return nil, err
+	if c.Limiter != nil {
+		if err = c.Limiter.Wait(); err != nil {
+			return nil, err
+		}
 	}
+
+	data, err = c.httpRequest(URL, client, method, conditional)
 	c.lastRequest = time.Now()
 
-	return data, nil
+	return data, err
 }
 
-// retrieve URL with given client.
-func (c *Client) httpRequest(URL string, client *http.Client, method ...string) ([]byte, error) {
+// retrieve URL with given client. If conditional is true and Client has
+// a cached response for URL, a conditional request is made.
+func (c *Client) httpRequest(URL string, client *http.Client, method string, conditional bool) ([]byte, error) {
 	var (
-		meth = "GET"
-		req  *http.Request
-		r    *http.Response
-		data []byte
-		err  error
+		req    *http.Request
+		r      *http.Response
+		cached *cachedResponse
+		data   []byte
+		err    error
 	)
-	if len(method) > 0 {
-		meth = method[0]
-	}
 	c.Log.Printf("[http] retrieving %q ...", cleanURL(URL))
 
-	if req, err = http.NewRequest(strings.ToUpper(meth), URL, nil); err != nil {
+	if req, err = http.NewRequest(strings.ToUpper(method), URL, nil); err != nil {
 		return nil, errors.Wrap(err, "build HTTP request")
 	}
 	req.Header.Set("User-Agent", userAgent)
 
+	if conditional {
+		if cached = c.loadResponse(URL); cached != nil {
+			if cached.ETag != "" {
+				req.Header.Set("If-None-Match", cached.ETag)
+			}
+			if cached.LastModified != "" {
+				req.Header.Set("If-Modified-Since", cached.LastModified)
+			}
+		}
+	}
+
 	if r, err = client.Do(req); err != nil {
 		return nil, errors.Wrap(err, "retrieve URL")
 	}
 	defer r.Body.Close()
 	c.Log.Printf("[%d] %s", r.StatusCode, cleanURL(URL))
 
+	if r.StatusCode == http.StatusNotModified && cached != nil {
+		return cached.Body, ErrNotModified
+	}
+
 	if r.StatusCode > 299 {
-		return nil, errors.Wrap(fmt.Errorf("%s: %s", URL, r.Status), "retrieve URL")
+		return nil, errors.Wrap(&HTTPError{URL: URL, StatusCode: r.StatusCode, Status: r.Status}, "retrieve URL")
 	}
 
 	if data, err = ioutil.ReadAll(r.Body); err != nil {
 		return nil, errors.Wrap(err, "read HTTP response")
 	}
 
+	if conditional {
+		c.saveResponse(URL, r, data)
+	}
+
 	return data, nil
 }
 
+// HTTPError is returned when the server responds with an error status.
+type HTTPError struct {
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+// Error implements error.
+func (e *HTTPError) Error() string { return fmt.Sprintf("%s: %s", e.URL, e.Status) }
+
+// IsTemporary returns true if err is a network error or a server error,
+// i.e. the request may succeed if it is retried later. Other errors,
+// such as a 404 for a non-existent shelf, are permanent.
+func IsTemporary(err error) bool {
+	switch e := errors.Cause(err).(type) {
+	case *HTTPError:
+		return e.StatusCode >= 500 || e.StatusCode == http.StatusTooManyRequests
+	case net.Error:
+		return true
+	default:
+		return false
+	}
+}
+
 func cleanURL(URL string) string {
 	if u, err := url.Parse(URL); err == nil {
 		v := u.Query()
@@ -0,0 +1,52 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package gr
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIsTemporary distinguishes network & server errors from permanent ones
+func TestIsTemporary(t *testing.T) {
+	t.Parallel()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/404":
+			w.WriteHeader(http.StatusNotFound)
+		case "/429":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/500":
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	down.Close()
+	defer ts.Close()
+
+	tests := []struct {
+		URL string
+		x   bool
+	}{
+		{ts.URL + "/404", false},
+		{ts.URL + "/429", true},
+		{ts.URL + "/500", true},
+		{down.URL, true},
+	}
+
+	c := &Client{Log: nullLogger{}}
+	// no parallel subtests, as they'd run after the servers are closed
+	for _, td := range tests {
+		_, err := c.cachedGet(td.URL)
+		require.NotNil(t, err, "expected error for %s", td.URL)
+		assert.Equal(t, td.x, IsTemporary(err), "unexpected result for %s", td.URL)
+	}
+	assert.False(t, IsTemporary(errors.New("other")), "plain error")
+	assert.False(t, IsTemporary(nil), "nil error")
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package gr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/natefinch/atomic"
+	"github.com/pkg/errors"
+)
+
+// ErrNotModified is returned alongside the cached data by methods that make
+// conditional requests when the server reports the resource is unchanged.
+var ErrNotModified = errors.New("not modified")
+
+// HTTPCache stores HTTP responses, so Client can make conditional requests.
+// Get should return nil data (not an error) if nothing is stored for key.
+type HTTPCache interface {
+	Get(key string) ([]byte, error)
+	Set(key string, data []byte) error
+}
+
+// DirCache is an HTTPCache that stores responses as files in a directory.
+type DirCache struct {
+	Dir string
+}
+
+var _ HTTPCache = (*DirCache)(nil)
+
+// NewDirCache creates a DirCache, creating the directory if necessary.
+func NewDirCache(dir string) (*DirCache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "create cache directory")
+	}
+	return &DirCache{Dir: dir}, nil
+}
+
+// Get implements HTTPCache.
+func (dc *DirCache) Get(key string) ([]byte, error) {
+	data, err := ioutil.ReadFile(dc.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// Set implements HTTPCache.
+func (dc *DirCache) Set(key string, data []byte) error {
+	return atomic.WriteFile(dc.path(key), bytes.NewReader(data))
+}
+
+// path of cache file for key.
+func (dc *DirCache) path(key string) string {
+	return filepath.Join(dc.Dir, fmt.Sprintf("%x.json", sha256.Sum256([]byte(key))))
+}
+
+// response saved in HTTPCache.
+type cachedResponse struct {
+	ETag         string
+	LastModified string
+	Body         []byte
+}
+
+// load cached response for URL. Returns nil if Client has no cache or
+// there is no usable response.
+func (c *Client) loadResponse(URL string) *cachedResponse {
+	if c.Cache == nil {
+		return nil
+	}
+	data, err := c.Cache.Get(URL)
+	if err != nil {
+		c.Log.Printf("[cache] load %s: %v", cleanURL(URL), err)
+		return nil
+	}
+	if data == nil {
+		return nil
+	}
+	var cr cachedResponse
+	if err := json.Unmarshal(data, &cr); err != nil {
+		c.Log.Printf("[cache] decode %s: %v", cleanURL(URL), err)
+		return nil
+	}
+	if cr.ETag == "" && cr.LastModified == "" {
+		return nil
+	}
+	return &cr
+}
+
+// save response for URL if it has validators.
+func (c *Client) saveResponse(URL string, r *http.Response, body []byte) {
+	if c.Cache == nil {
+		return
+	}
+	cr := cachedResponse{
+		ETag:         r.Header.Get("ETag"),
+		LastModified: r.Header.Get("Last-Modified"),
+		Body:         body,
+	}
+	if cr.ETag == "" && cr.LastModified == "" {
+		return
+	}
+	data, err := json.Marshal(cr)
+	if err == nil {
+		err = c.Cache.Set(URL, data)
+	}
+	if err != nil {
+		c.Log.Printf("[cache] save %s: %v", cleanURL(URL), err)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package gr
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDirCache stores and retrieves data
+func TestDirCache(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gr-")
+	require.Nil(t, err, "create temp dir")
+	defer os.RemoveAll(dir)
+
+	dc, err := NewDirCache(dir)
+	require.Nil(t, err, "create cache")
+
+	data, err := dc.Get("https://example.com")
+	assert.Nil(t, err, "get missing key")
+	assert.Nil(t, data, "data for missing key")
+
+	require.Nil(t, dc.Set("https://example.com", []byte("data")), "set key")
+	data, err = dc.Get("https://example.com")
+	assert.Nil(t, err, "get key")
+	assert.Equal(t, []byte("data"), data, "unexpected data")
+}
+
+// TestConditionalRequest sends validators and uses cached data on 304
+func TestConditionalRequest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name, header, value, condHeader string
+	}{
+		{"etag", "ETag", `"v1"`, "If-None-Match"},
+		{"last-modified", "Last-Modified", "Sat, 08 Aug 2020 12:00:00 GMT", "If-Modified-Since"},
+	}
+
+	for _, td := range tests {
+		td := td
+		t.Run(td.name, func(t *testing.T) {
+			t.Parallel()
+			var requests, full int
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.Header.Get(td.condHeader) == td.value {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				full++
+				w.Header().Set(td.header, td.value)
+				w.Write([]byte("body"))
+			}))
+			defer ts.Close()
+
+			dir, err := ioutil.TempDir("", "gr-")
+			require.Nil(t, err, "create temp dir")
+			defer os.RemoveAll(dir)
+
+			c := &Client{Log: nullLogger{}}
+			// no cache: no conditional requests
+			for i := 0; i < 2; i++ {
+				data, err := c.cachedGet(ts.URL)
+				assert.Nil(t, err, "uncached request")
+				assert.Equal(t, []byte("body"), data, "unexpected data")
+			}
+			assert.Equal(t, 2, full, "unexpected full responses")
+
+			c.Cache, err = NewDirCache(dir)
+			require.Nil(t, err, "create cache")
+
+			data, err := c.cachedGet(ts.URL)
+			assert.Nil(t, err, "first request")
+			assert.Equal(t, []byte("body"), data, "unexpected data")
+
+			data, err = c.cachedGet(ts.URL)
+			assert.Equal(t, ErrNotModified, err, "second request")
+			assert.Equal(t, []byte("body"), data, "unexpected cached data")
+			assert.Equal(t, 4, requests, "unexpected requests")
+			assert.Equal(t, 3, full, "unexpected full responses")
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package gr
+
+import (
+	"io/ioutil"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Limiter spaces API requests. Wait should block until a request may be
+// made and then record that one was made.
+type Limiter interface {
+	Wait() error
+}
+
+// FileLimiter is a Limiter that is shared between processes. The time of
+// the last request is kept in a file, which is locked while waiting, so
+// processes make their requests one after another.
+type FileLimiter struct {
+	Path     string
+	Interval time.Duration // minimum time between requests
+}
+
+var _ Limiter = (*FileLimiter)(nil)
+
+// NewFileLimiter creates a FileLimiter that stores the time of the last
+// request in the file at path.
+func NewFileLimiter(path string, interval time.Duration) *FileLimiter {
+	return &FileLimiter{Path: path, Interval: interval}
+}
+
+// Wait implements Limiter.
+func (l *FileLimiter) Wait() error {
+	f, err := os.OpenFile(l.Path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrap(err, "open limiter")
+	}
+	defer f.Close()
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		return errors.Wrap(err, "lock limiter")
+	}
+	defer syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return errors.Wrap(err, "read limiter")
+	}
+	var last time.Time
+	if len(data) > 0 {
+		if err := last.UnmarshalText(data); err != nil {
+			return errors.Wrap(err, "parse limiter")
+		}
+	}
+	if d := l.Interval - time.Since(last); d > 0 {
+		time.Sleep(d)
+	}
+
+	if data, err = time.Now().MarshalText(); err != nil {
+		return errors.Wrap(err, "update limiter")
+	}
+	if err := f.Truncate(0); err != nil {
+		return errors.Wrap(err, "update limiter")
+	}
+	if _, err := f.WriteAt(data, 0); err != nil {
+		return errors.Wrap(err, "update limiter")
+	}
+	return nil
+}
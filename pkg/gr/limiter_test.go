@@ -0,0 +1,56 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package gr
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFileLimiter spaces requests from concurrent limiters sharing a file
+func TestFileLimiter(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "gr-")
+	require.Nil(t, err, "create temp dir")
+	defer os.RemoveAll(dir)
+
+	var (
+		path     = filepath.Join(dir, "last_request")
+		interval = 50 * time.Millisecond
+		times    []time.Time
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+	)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// separate limiters, as if in separate processes
+			assert.Nil(t, NewFileLimiter(path, interval).Wait(), "wait")
+			mu.Lock()
+			times = append(times, time.Now())
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 3, len(times), "unexpected requests")
+	first, last := times[0], times[0]
+	for _, tm := range times {
+		if tm.Before(first) {
+			first = tm
+		}
+		if tm.After(last) {
+			last = tm
+		}
+	}
+	assert.True(t, last.Sub(first) >= 2*interval, "requests not spaced: %v", last.Sub(first))
+}
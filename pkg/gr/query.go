@@ -0,0 +1,241 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package gr
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Query is a search query with optional filters and sort order, e.g.
+// `discworld author:pratchett rating:>4 sort:year -witches`.
+//
+// Supported terms are:
+//
+//	author:<name>     author's name contains <name>
+//	series:<title>    title of one of the book's series contains <title>
+//	year:<op><year>   book was published in, before or after <year>
+//	rating:<op><n>    book's rating is <n> or above, or compared with <n>
+//	sort:<field>      sort by rating (highest first), year (oldest first)
+//	                  or title. Prefix field with "-" to reverse the order.
+//	-<word>           title, author and series don't contain <word>
+//
+// <op> is one of =, >, >=, < or <=. Values containing spaces can be
+// quoted, e.g. author:"terry pratchett". Any other terms are free text.
+type Query struct {
+	Words   []string // free text
+	Exclude []string // lowercase words that must not match
+	Author  string   // lowercase author name
+	Series  string   // lowercase series title
+	Year    NumFilter
+	Rating  NumFilter
+	Sort    string // "rating", "year" or "title", optionally prefixed with "-"
+}
+
+// NumFilter compares a number with a value. The zero value matches everything.
+type NumFilter struct {
+	Op    string // =, >, >=, < or <=
+	Value float64
+}
+
+// Match returns true if v satisfies the filter.
+func (f NumFilter) Match(v float64) bool {
+	switch f.Op {
+	case "=":
+		return v == f.Value
+	case ">":
+		return v > f.Value
+	case ">=":
+		return v >= f.Value
+	case "<":
+		return v < f.Value
+	case "<=":
+		return v <= f.Value
+	}
+	return true
+}
+
+// IsZero returns true if filter is unset.
+func (f NumFilter) IsZero() bool { return f.Op == "" }
+
+// ParseQuery parses a query string. Terms that aren't valid filters are
+// treated as free text.
+func ParseQuery(s string) Query {
+	var q Query
+	for _, term := range splitQuery(s) {
+		if !q.parseTerm(term) {
+			q.Words = append(q.Words, term)
+		}
+	}
+	return q
+}
+
+// parse a filter term. Returns false if term isn't a valid filter.
+func (q *Query) parseTerm(term string) bool {
+	if len(term) > 1 && term[0] == '-' {
+		q.Exclude = append(q.Exclude, strings.ToLower(term[1:]))
+		return true
+	}
+
+	i := strings.Index(term, ":")
+	if i < 1 || i == len(term)-1 {
+		return false
+	}
+	key, value := strings.ToLower(term[:i]), term[i+1:]
+	switch key {
+	case "author":
+		q.Author = strings.ToLower(value)
+	case "series":
+		q.Series = strings.ToLower(value)
+	case "year":
+		f, ok := parseNumFilter(value, "=")
+		if !ok {
+			return false
+		}
+		q.Year = f
+	case "rating":
+		f, ok := parseNumFilter(value, ">=")
+		if !ok {
+			return false
+		}
+		q.Rating = f
+	case "sort":
+		value = strings.ToLower(value)
+		switch strings.TrimPrefix(value, "-") {
+		case "rating", "year", "title":
+			q.Sort = value
+		default:
+			return false
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// parse a comparison like ">=4". If s has no operator, defaultOp is used.
+func parseNumFilter(s, defaultOp string) (NumFilter, bool) {
+	op := defaultOp
+	for _, o := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(s, o) {
+			op, s = o, s[len(o):]
+			break
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return NumFilter{}, false
+	}
+	return NumFilter{Op: op, Value: v}, true
+}
+
+// split query into terms on whitespace. Double-quoted sections may contain
+// whitespace; the quotes are removed.
+func splitQuery(s string) []string {
+	var (
+		terms   []string
+		term    strings.Builder
+		quoted  bool
+		started bool // term has content, possibly an empty quoted string
+	)
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			started = true
+		case unicode.IsSpace(r) && !quoted:
+			if started {
+				terms = append(terms, term.String())
+				term.Reset()
+				started = false
+			}
+		default:
+			term.WriteRune(r)
+			started = true
+		}
+	}
+	if started {
+		terms = append(terms, term.String())
+	}
+	return terms
+}
+
+// Text returns the free text of the query.
+func (q Query) Text() string { return strings.Join(q.Words, " ") }
+
+// Match returns true if book satisfies the query's filters. Free text is
+// not considered.
+func (q Query) Match(b Book) bool {
+	if q.Author != "" && !strings.Contains(strings.ToLower(b.Author.Name), q.Author) {
+		return false
+	}
+	if q.Series != "" && !strings.Contains(strings.ToLower(seriesTitles(b)), q.Series) {
+		return false
+	}
+	if !q.Year.IsZero() {
+		d, _ := b.Published()
+		if d.IsZero() || !q.Year.Match(float64(d.Year())) {
+			return false
+		}
+	}
+	if !q.Rating.Match(b.Rating) {
+		return false
+	}
+	if len(q.Exclude) > 0 {
+		s := strings.ToLower(b.Title + " " + b.Author.Name + " " + seriesTitles(b))
+		for _, w := range q.Exclude {
+			if strings.Contains(s, w) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Apply returns the books that match the query's filters in the query's
+// sort order. Free text is not considered.
+func (q Query) Apply(books []Book) []Book {
+	matches := []Book{}
+	for _, b := range books {
+		if q.Match(b) {
+			matches = append(matches, b)
+		}
+	}
+
+	var less func(a, b Book) bool
+	switch strings.TrimPrefix(q.Sort, "-") {
+	case "rating":
+		less = func(a, b Book) bool { return a.Rating > b.Rating }
+	case "year":
+		less = func(a, b Book) bool {
+			x, _ := a.Published()
+			y, _ := b.Published()
+			if x.IsZero() || y.IsZero() { // unknown dates last
+				return !x.IsZero()
+			}
+			return x.Year() < y.Year()
+		}
+	case "title":
+		less = func(a, b Book) bool { return strings.ToLower(a.Title) < strings.ToLower(b.Title) }
+	default:
+		return matches
+	}
+	if strings.HasPrefix(q.Sort, "-") {
+		fn := less
+		less = func(a, b Book) bool { return fn(b, a) }
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return less(matches[i], matches[j]) })
+	return matches
+}
+
+// titles of all book's series.
+func seriesTitles(b Book) string {
+	titles := []string{b.Series.Title}
+	for _, s := range b.OtherSeries {
+		titles = append(titles, s.Title)
+	}
+	return strings.Join(titles, " ")
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package gr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fxtlabs/date"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseQuery parses filters, sort order & free text
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in string
+		x  Query
+	}{
+		{"", Query{}},
+		{"discworld", Query{Words: []string{"discworld"}}},
+		{"  colour   of magic ", Query{Words: []string{"colour", "of", "magic"}}},
+		{"author:Pratchett", Query{Author: "pratchett"}},
+		{`author:"Terry Pratchett" magic`, Query{Author: "terry pratchett", Words: []string{"magic"}}},
+		{`"colour of"`, Query{Words: []string{"colour of"}}},
+		{"series:discworld", Query{Series: "discworld"}},
+		{"year:>2000", Query{Year: NumFilter{">", 2000}}},
+		{"year:1983", Query{Year: NumFilter{"=", 1983}}},
+		{"year:<=1990", Query{Year: NumFilter{"<=", 1990}}},
+		{"rating:>=4", Query{Rating: NumFilter{">=", 4}}},
+		{"rating:4.2", Query{Rating: NumFilter{">=", 4.2}}},
+		{"RATING:<3", Query{Rating: NumFilter{"<", 3}}},
+		{"sort:rating", Query{Sort: "rating"}},
+		{"sort:-Year", Query{Sort: "-year"}},
+		{"-Witches", Query{Exclude: []string{"witches"}}},
+		// invalid filters are free text
+		{"-", Query{Words: []string{"-"}}},
+		{"year:soon", Query{Words: []string{"year:soon"}}},
+		{"sort:pages", Query{Words: []string{"sort:pages"}}},
+		{"author:", Query{Words: []string{"author:"}}},
+		{"isbn:123", Query{Words: []string{"isbn:123"}}},
+		{"http://example.com", Query{Words: []string{"http://example.com"}}},
+		{
+			"magic author:pratchett rating:>4 sort:year -witches",
+			Query{
+				Words:   []string{"magic"},
+				Author:  "pratchett",
+				Rating:  NumFilter{">", 4},
+				Sort:    "year",
+				Exclude: []string{"witches"},
+			},
+		},
+	}
+
+	for _, td := range tests {
+		td := td
+		t.Run(td.in, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, td.x, ParseQuery(td.in), "unexpected query")
+		})
+	}
+}
+
+// TestQueryApply filters and sorts books
+func TestQueryApply(t *testing.T) {
+	t.Parallel()
+	var (
+		colour = Book{ID: 1, Title: "The Colour of Magic", Author: Author{Name: "Terry Pratchett"},
+			Series: Series{Title: "Discworld"}, Rating: 3.98, PubDate: date.New(1983, time.November, 24)}
+		witches = Book{ID: 2, Title: "Witches Abroad", Author: Author{Name: "Terry Pratchett"},
+			Series: Series{Title: "Discworld"}, OtherSeries: []Series{{Title: "Witches"}}, Rating: 4.26,
+			PubDate: date.New(1991, time.January, 1)}
+		goodOmens = Book{ID: 3, Title: "Good Omens", Author: Author{Name: "Terry Pratchett"},
+			Rating: 4.25, EditionPubDate: date.New(2006, time.November, 28)}
+		dune = Book{ID: 4, Title: "Dune", Author: Author{Name: "Frank Herbert"}, Rating: 4.25}
+		all  = []Book{colour, witches, goodOmens, dune}
+	)
+
+	tests := []struct {
+		query string
+		x     []Book
+	}{
+		{"", all},
+		{"magic", all}, // free text is ignored
+		{"author:pratchett", []Book{colour, witches, goodOmens}},
+		{"series:witches", []Book{witches}},
+		{"series:discworld -witches", []Book{colour}},
+		{"rating:>4", []Book{witches, goodOmens, dune}},
+		{"year:<2000", []Book{colour, witches}},
+		{"year:2006", []Book{goodOmens}}, // falls back to edition date
+		{"author:pratchett rating:>4", []Book{witches, goodOmens}},
+		{"sort:rating", []Book{witches, goodOmens, dune, colour}},
+		{"sort:-rating", []Book{colour, goodOmens, dune, witches}},
+		{"sort:year", []Book{colour, witches, goodOmens, dune}},
+		{"sort:title", []Book{dune, goodOmens, colour, witches}},
+		{"author:herbert -dune", []Book{}},
+	}
+
+	for _, td := range tests {
+		td := td
+		t.Run(td.query, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, td.x, ParseQuery(td.query).Apply(all), "unexpected books")
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package gr
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	reviewURL       = "https://www.goodreads.com/review/show_by_user_and_book.xml"
+	reviewCreateURL = "https://www.goodreads.com/review.xml"
+	reviewEditURL   = "https://www.goodreads.com/review/%d.xml"
+)
+
+// FinishBook adds a book to the user's "read" shelf and sets the date
+// it was read.
+func (c *Client) FinishBook(userID, bookID int64, t time.Time) error {
+	if err := c.shelveWithDate(userID, bookID, "read", t); err != nil {
+		return errors.Wrap(err, "finish book")
+	}
+	return nil
+}
+
+// StartBook adds a book to the user's "currently-reading" shelf and sets
+// the date it was started.
+func (c *Client) StartBook(userID, bookID int64, t time.Time) error {
+	if err := c.shelveWithDate(userID, bookID, "currently-reading", t); err != nil {
+		return errors.Wrap(err, "start book")
+	}
+	return nil
+}
+
+// add book to shelf via the review API, which can also set read dates.
+// Books already on a shelf have a review, which must be edited instead.
+func (c *Client) shelveWithDate(userID, bookID int64, shelf string, t time.Time) error {
+	id, err := c.reviewID(userID, bookID)
+	if err != nil {
+		return err
+	}
+	if id == 0 {
+		_, err = c.apiRequest(urlForReviewCreate(bookID, shelf, t), "POST")
+	} else {
+		_, err = c.apiRequest(urlForReviewEdit(id, shelf, t), "PUT")
+	}
+	return err
+}
+
+// returns ID of user's review of book or 0 if the book isn't on any
+// of the user's shelves.
+func (c *Client) reviewID(userID, bookID int64) (int64, error) {
+	data, err := c.apiRequest(urlForReview(userID, bookID))
+	if err != nil {
+		if e, ok := errors.Cause(err).(*HTTPError); ok && e.StatusCode == http.StatusNotFound {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return unmarshalReviewID(data)
+}
+
+func unmarshalReviewID(data []byte) (int64, error) {
+	v := struct {
+		ID      int64    `xml:"review>id"`
+		XMLName xml.Name `xml:"GoodreadsResponse"`
+	}{}
+	if err := xml.Unmarshal(data, &v); err != nil {
+		return 0, errors.Wrap(err, "unmarshal review")
+	}
+	return v.ID, nil
+}
+
+func urlForReview(userID, bookID int64) string {
+	u, _ := url.Parse(reviewURL)
+	v := u.Query()
+	v.Set("user_id", fmt.Sprintf("%d", userID))
+	v.Set("book_id", fmt.Sprintf("%d", bookID))
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+func urlForReviewCreate(bookID int64, shelf string, t time.Time) string {
+	u, _ := url.Parse(reviewCreateURL)
+	v := reviewValues(shelf, t)
+	v.Set("book_id", fmt.Sprintf("%d", bookID))
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+func urlForReviewEdit(reviewID int64, shelf string, t time.Time) string {
+	u, _ := url.Parse(fmt.Sprintf(reviewEditURL, reviewID))
+	u.RawQuery = reviewValues(shelf, t).Encode()
+	return u.String()
+}
+
+// shelf and read date parameters. Books on "read" get the date they were
+// finished, other books the date they were started.
+func reviewValues(shelf string, t time.Time) url.Values {
+	v := url.Values{}
+	v.Set("shelf", shelf)
+	if shelf == "read" {
+		v.Set("review[read_at]", t.Format("2006-01-02"))
+		v.Set("finished", "true")
+	} else {
+		v.Set("review[started_at]", t.Format("2006-01-02"))
+	}
+	return v
+}
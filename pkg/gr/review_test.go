@@ -0,0 +1,58 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package gr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Review ID of book on user's shelves
+func TestParseReviewID(t *testing.T) {
+	t.Parallel()
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<GoodreadsResponse>
+  <review>
+    <id>3337623363</id>
+    <book>
+      <id type="integer">31379281</id>
+    </book>
+    <rating>0</rating>
+  </review>
+</GoodreadsResponse>`)
+
+	id, err := unmarshalReviewID(data)
+	assert.Nil(t, err, "unmarshal review")
+	assert.Equal(t, int64(3337623363), id, "unexpected ID")
+}
+
+// Review URLs with read dates
+func TestReviewURL(t *testing.T) {
+	t.Parallel()
+	var (
+		day   = time.Date(2020, 8, 9, 12, 0, 0, 0, time.UTC)
+		tests = []struct {
+			name, x, URL string
+		}{
+			{"review", "https://www.goodreads.com/review/show_by_user_and_book.xml?book_id=22&user_id=1",
+				urlForReview(1, 22)},
+			{"create-read", "https://www.goodreads.com/review.xml?book_id=22&finished=true&review%5Bread_at%5D=2020-08-09&shelf=read",
+				urlForReviewCreate(22, "read", day)},
+			{"create-reading", "https://www.goodreads.com/review.xml?book_id=22&review%5Bstarted_at%5D=2020-08-09&shelf=currently-reading",
+				urlForReviewCreate(22, "currently-reading", day)},
+			{"edit-read", "https://www.goodreads.com/review/333.xml?finished=true&review%5Bread_at%5D=2020-08-09&shelf=read",
+				urlForReviewEdit(333, "read", day)},
+		}
+	)
+
+	for _, td := range tests {
+		td := td
+		t.Run(td.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, td.x, td.URL, "unexpected URL")
+		})
+	}
+}
@@ -9,9 +9,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
-	"github.com/fxtlabs/date"
 	"github.com/pkg/errors"
 )
 
@@ -19,10 +17,21 @@ const seriesURL = "https://www.goodreads.com/series/%d?format=xml&key=%s"
 
 // Series is a Goodreads series.
 type Series struct {
-	Title    string  // series title
-	Position float64 // position of current book in series
-	ID       int64   // only set in book details
-	Books    []Book  // only set by series endpoint
+	Title       string  // series title
+	Position    float64 // position of current book in series
+	PositionEnd float64 // last position if book spans several, e.g. an omnibus
+	// Original position if it isn't a plain number, e.g. "1-3" or "0.5a"
+	PositionLabel string
+	ID            int64  // only set in book details
+	Books         []Book // only set by series endpoint
+}
+
+// Number returns the book's position in the series as text.
+func (s Series) Number() string {
+	if s.PositionLabel != "" {
+		return s.PositionLabel
+	}
+	return strconv.FormatFloat(s.Position, 'f', -1, 64)
 }
 
 // String returns series name and book position.
@@ -30,10 +39,45 @@ func (s Series) String() string {
 	if s.Title == "" {
 		return ""
 	}
-	return fmt.Sprintf("%s #%.1f", s.Title, s.Position)
+	return fmt.Sprintf("%s #%s", s.Title, s.Number())
+}
+
+// set Position, PositionEnd and PositionLabel from a position string
+// such as "3", "12.5", "1-3" or "0.5a".
+func (s *Series) setPosition(pos string) {
+	pos = strings.TrimSpace(pos)
+	if pos == "" {
+		return
+	}
+
+	if f, err := strconv.ParseFloat(pos, 64); err == nil {
+		s.Position = f
+		return
+	}
+
+	s.PositionLabel = pos
+	parts := strings.FieldsFunc(pos, func(r rune) bool { return r == '-' || r == '–' })
+	if len(parts) > 0 {
+		s.Position = leadingNumber(parts[0])
+	}
+	if len(parts) > 1 {
+		s.PositionEnd = leadingNumber(parts[len(parts)-1])
+	}
 }
 
-// Series fetches the full details of a book.
+// parse number at start of string, ignoring any suffix, e.g. "0.5a" -> 0.5.
+func leadingNumber(s string) float64 {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+	if i >= 0 {
+		s = s[:i]
+	}
+	f, _ := strconv.ParseFloat(strings.TrimSuffix(s, "."), 64)
+	return f
+}
+
+// Series fetches the full details of a series. If Client has a Cache
+// and the series is unchanged, the cached series is returned with ErrNotModified.
 func (c *Client) Series(id int64) (Series, error) {
 	var (
 		u    = fmt.Sprintf(seriesURL, id, c.APIKey)
@@ -41,11 +85,17 @@ func (c *Client) Series(id int64) (Series, error) {
 		err  error
 	)
 
-	if data, err = c.apiRequest(u); err != nil {
+	if data, err = c.cachedAPIRequest(u); err != nil && err != ErrNotModified {
 		return Series{}, errors.Wrap(err, "fetch series")
 	}
 
-	return unmarshalSeries(data)
+	notModified := err == ErrNotModified
+
+	series, err := unmarshalSeries(data)
+	if err == nil && notModified {
+		err = ErrNotModified
+	}
+	return series, err
 }
 
 func unmarshalSeries(data []byte) (Series, error) {
@@ -80,35 +130,25 @@ func unmarshalSeries(data []byte) (Series, error) {
 	}
 
 	for _, w := range v.Series.Works {
-		var pos float64
-		if f, err := strconv.ParseFloat(w.Position, 64); err == nil {
-			pos = f
-		}
 		b := Book{
 			ID:            w.BookID,
 			WorkID:        w.WorkID,
 			Title:         strings.TrimSpace(w.Title),
 			TitleNoSeries: strings.TrimSpace(w.TitleNoSeries),
-			Series:        Series{ID: series.ID, Title: series.Title, Position: pos},
+			Series:        Series{ID: series.ID, Title: series.Title},
 			Author:        Author{ID: w.AuthorID, Name: w.AuthorName, URL: fmt.Sprintf("https://www.goodreads.com/author/show/%d", w.AuthorID)},
 			Rating:        w.Rating,
 			URL:           fmt.Sprintf("https://www.goodreads.com/book/show/%d", w.BookID),
 			ImageURL:      w.ImageURL,
 		}
 
+		b.Series.setPosition(w.Position)
+
 		if b.TitleNoSeries == "" {
 			b.TitleNoSeries, _ = parseTitle(b.Title)
 		}
 
-		if w.Month == 0 {
-			w.Month = 1
-		}
-		if w.Day == 0 {
-			w.Day = 1
-		}
-		if w.Year != 0 {
-			b.PubDate = date.New(w.Year, time.Month(w.Month), w.Day)
-		}
+		b.PubDate, b.PubDatePrecision = newPubDate(w.Year, w.Month, w.Day)
 
 		series.Books = append(series.Books, b)
 	}
@@ -7,18 +7,21 @@ import (
 	"encoding/xml"
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 	"time"
 
-	"github.com/fxtlabs/date"
 	"github.com/pkg/errors"
 )
 
 const (
-	shelfURL      = "https://www.goodreads.com/review/list.xml?v=2&id=%d&shelf=%s&page=%d&per_page=50&sort=position"
+	shelfURL      = "https://www.goodreads.com/review/list.xml?v=2&id=%d&shelf=%s&page=%d&per_page=50&sort=%s&order=%s"
 	shelvesURL    = "https://www.goodreads.com/shelf/list.xml?user_id=%d&page=%d"
 	shelfAddURL   = "https://www.goodreads.com/shelf/add_to_shelf.xml"
 	shelvesAddURL = "https://www.goodreads.com/shelf/add_books_to_shelves.xml"
+
+	// format of review dates
+	reviewTimeLayout = "Mon Jan 02 15:04:05 -0700 2006"
 )
 
 // Shelf is a user's bookshelf/list.
@@ -29,6 +32,11 @@ type Shelf struct {
 	Size     int    // number of books on shelf
 	Books    []Book // not populated in shelf list
 	Selected bool
+
+	// A book can only be on one exclusive shelf ("read", "currently-reading",
+	// "to-read" or a custom exclusive shelf) at a time. Adding a book to one
+	// removes it from the others.
+	Exclusive bool
 }
 
 // String implements Stringer.
@@ -58,23 +66,134 @@ func (s ShelvesByName) Len() int           { return len(s) }
 func (s ShelvesByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
 func (s ShelvesByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
 
-// UserShelf returns the books on the specified shelf.
-func (c *Client) UserShelf(userID int64, name string, page int) ([]Book, PageData, error) {
-	if page == 0 {
-		page = 1
+// ShelfSort is an order of the books on a shelf.
+type ShelfSort string
+
+// Orders Goodreads can sort shelves by.
+const (
+	SortPosition  ShelfSort = "position"   // shelf's own order
+	SortDateAdded ShelfSort = "date_added" // newest first
+	SortDateRead  ShelfSort = "date_read"  // newest first
+	SortRating    ShelfSort = "rating"     // user's rating, highest first
+	SortAuthor    ShelfSort = "author"
+	SortTitle     ShelfSort = "title"
+
+	// review last updated, newest first. Not in ShelfSorts, as it's used
+	// to synchronise shelves, not to show them.
+	SortDateUpdated ShelfSort = "date_updated"
+)
+
+// ShelfSorts are all supported shelf orders.
+var ShelfSorts = []ShelfSort{SortPosition, SortDateAdded, SortDateRead, SortRating, SortAuthor, SortTitle}
+
+// ParseShelfSort returns the ShelfSort called s. An empty string is SortPosition.
+func ParseShelfSort(s string) (ShelfSort, error) {
+	if s == "" {
+		return SortPosition, nil
+	}
+	for _, o := range ShelfSorts {
+		if string(o) == s {
+			return o, nil
+		}
+	}
+	return "", errors.Errorf("unknown shelf sort %q", s)
+}
+
+// Title is the human-readable name of the order.
+func (o ShelfSort) Title() string {
+	switch o {
+	case SortDateAdded:
+		return "Date Added"
+	case SortDateRead:
+		return "Date Read"
+	case SortRating:
+		return "Your Rating"
+	case SortAuthor:
+		return "Author"
+	case SortTitle:
+		return "Title"
+	default:
+		return "Position"
 	}
+}
+
+// Order is the direction Goodreads should sort in: "d" (descending) for dates
+// and ratings, otherwise "a" (ascending).
+func (o ShelfSort) Order() string {
+	switch o {
+	case SortDateAdded, SortDateRead, SortDateUpdated, SortRating:
+		return "d"
+	default:
+		return "a"
+	}
+}
+
+// MergeShelf merges books whose reviews changed since a shelf was cached
+// into the cached books, which are in the given order. changed should be
+// the books returned by UserShelf in SortDateUpdated order up to the first
+// unchanged book. Books already on the shelf are updated in place, but new
+// books can only be placed in SortDateAdded order, and any change may move
+// a book in SortRating and SortDateRead order. It returns false if changed
+// can't be merged and the shelf must be fetched in full.
+func MergeShelf(cached, changed []Book, order ShelfSort) ([]Book, bool) {
+	if len(changed) > 0 && (order == SortRating || order == SortDateRead) {
+		return nil, false
+	}
+
+	var (
+		merged = append([]Book{}, cached...)
+		index  = make(map[int64]int, len(cached))
+		added  []Book
+	)
+	for i, b := range cached {
+		index[b.ID] = i
+	}
+	for _, b := range changed {
+		if i, ok := index[b.ID]; ok {
+			merged[i] = b
+			continue
+		}
+		if order != SortDateAdded {
+			return nil, false
+		}
+		added = append(added, b)
+	}
+
+	sort.SliceStable(added, func(i, j int) bool { return added[i].DateAdded.After(added[j].DateAdded) })
+	return append(added, merged...), true
+}
 
+// UserShelf returns the books on the specified shelf in the given order.
+// If Client has a Cache and the shelf is unchanged, the cached books are
+// returned with ErrNotModified.
+func (c *Client) UserShelf(userID int64, name string, page int, sort ShelfSort) ([]Book, PageData, error) {
 	var (
-		u    = fmt.Sprintf(shelfURL, userID, name, page)
+		u    = urlForShelf(userID, name, page, sort)
 		data []byte
 		err  error
 	)
 
-	if data, err = c.apiRequest(u); err != nil {
+	if data, err = c.cachedAPIRequest(u); err != nil && err != ErrNotModified {
 		return nil, PageData{}, errors.Wrap(err, "fetch shelf")
 	}
 
-	return unmarshalShelf(data)
+	notModified := err == ErrNotModified
+
+	books, meta, err := unmarshalShelf(data)
+	if err == nil && notModified {
+		err = ErrNotModified
+	}
+	return books, meta, err
+}
+
+func urlForShelf(userID int64, name string, page int, sort ShelfSort) string {
+	if page == 0 {
+		page = 1
+	}
+	if sort == "" {
+		sort = SortPosition
+	}
+	return fmt.Sprintf(shelfURL, userID, url.QueryEscape(name), page, sort, sort.Order())
 }
 
 func unmarshalShelf(data []byte) ([]Book, PageData, error) {
@@ -89,22 +208,26 @@ func unmarshalShelf(data []byte) ([]Book, PageData, error) {
 			End   int `xml:"end,attr"`
 			Total int `xml:"total,attr"`
 
-			Books []struct {
-				ID            int64  `xml:"id"`
-				ISBN          string `xml:"isbn"`
-				ISBN13        string `xml:"isbn13"`
-				Title         string `xml:"title"`
-				TitleNoSeries string `xml:"title_without_series"`
-				Description   string `xml:"description"`
-				Year          int    `xml:"publication_year"`
-				Month         int    `xml:"publication_month"`
-				Day           int    `xml:"publication_day"`
-
-				Authors []Author `xml:"authors>author"`
-
-				Rating   float64 `xml:"average_rating"`
-				ImageURL string  `xml:"image_url"`
-			} `xml:"review>book"`
+			Reviews []struct {
+				Book struct {
+					ID            int64  `xml:"id"`
+					ISBN          string `xml:"isbn"`
+					ISBN13        string `xml:"isbn13"`
+					Title         string `xml:"title"`
+					TitleNoSeries string `xml:"title_without_series"`
+					Description   string `xml:"description"`
+					Year          int    `xml:"publication_year"`
+					Month         int    `xml:"publication_month"`
+					Day           int    `xml:"publication_day"`
+
+					Authors []Author `xml:"authors>author"`
+
+					Rating   float64 `xml:"average_rating"`
+					ImageURL string  `xml:"image_url"`
+				} `xml:"book"`
+				DateAdded   string `xml:"date_added"`
+				DateUpdated string `xml:"date_updated"`
+			} `xml:"review"`
 			XMLName xml.Name `xml:"reviews"`
 		}
 	}{}
@@ -116,7 +239,8 @@ func unmarshalShelf(data []byte) ([]Book, PageData, error) {
 	meta.End = v.List.End
 	meta.Total = v.List.Total
 
-	for _, r := range v.List.Books {
+	for _, rev := range v.List.Reviews {
+		r := rev.Book
 		_, series := parseTitle(r.Title)
 		b := Book{
 			ID:            r.ID,
@@ -124,34 +248,36 @@ func unmarshalShelf(data []byte) ([]Book, PageData, error) {
 			ISBN13:        r.ISBN13,
 			Title:         r.Title,
 			TitleNoSeries: r.TitleNoSeries,
-			Series:        series,
 			Description:   r.Description,
 			Rating:        r.Rating,
 			URL:           fmt.Sprintf("https://www.goodreads.com/book/show/%d", r.ID),
 			ImageURL:      r.ImageURL,
 		}
+		b.Series, b.OtherSeries = splitSeries(series)
 
 		if len(r.Authors) > 0 {
 			b.Author = r.Authors[0]
 			b.Author.URL = fmt.Sprintf("https://www.goodreads.com/author/show/%d", b.Author.ID)
 		}
 
-		if r.Month == 0 {
-			r.Month = 1
-		}
-		if r.Day == 0 {
-			r.Day = 1
-		}
-
-		if r.Year != 0 {
-			b.PubDate = date.New(r.Year, time.Month(r.Month), r.Day)
-		}
+		b.EditionPubDate, b.EditionPubDatePrecision = newPubDate(r.Year, r.Month, r.Day)
+		b.DateAdded = parseReviewTime(rev.DateAdded)
+		b.DateUpdated = parseReviewTime(rev.DateUpdated)
 		books = append(books, b)
 	}
 
 	return books, meta, nil
 }
 
+// parse a review date. Returns zero time if s is empty or invalid.
+func parseReviewTime(s string) time.Time {
+	t, err := time.Parse(reviewTimeLayout, strings.TrimSpace(s))
+	if err != nil {
+		return time.Time{}
+	}
+	return t.UTC()
+}
+
 // UserShelves retrieve user's shelves. Only basic shelf info (ID, name, book count) is returned.
 func (c *Client) UserShelves(userID int64, page int) (shelves []Shelf, meta PageData, err error) {
 	if page == 0 {
@@ -196,6 +322,7 @@ func unmarshalShelves(data []byte) ([]Shelf, PageData, error) {
 				ID        int64  `xml:"id"`
 				Name      string `xml:"name"`
 				BookCount int    `xml:"book_count"`
+				Exclusive bool   `xml:"exclusive_flag"`
 			} `xml:"user_shelf"`
 			XMLName xml.Name `xml:"shelves"`
 		}
@@ -210,9 +337,10 @@ func unmarshalShelves(data []byte) ([]Shelf, PageData, error) {
 
 	for _, r := range v.List.Shelves {
 		s := Shelf{
-			ID:   r.ID,
-			Name: r.Name,
-			Size: r.BookCount,
+			ID:        r.ID,
+			Name:      r.Name,
+			Size:      r.BookCount,
+			Exclusive: r.Exclusive,
 		}
 		shelves = append(shelves, s)
 	}
@@ -222,19 +350,30 @@ func unmarshalShelves(data []byte) ([]Shelf, PageData, error) {
 
 // AddToShelves adds a book to the specified shelves.
 func (c *Client) AddToShelves(bookID int64, shelves []string) error {
+	return c.AddBooksToShelves([]int64{bookID}, shelves)
+}
+
+// AddBooksToShelves adds books to the specified shelves in a single request.
+func (c *Client) AddBooksToShelves(bookIDs []int64, shelves []string) error {
+	if _, err := c.apiRequest(urlForAddBooks(bookIDs, shelves), "POST"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func urlForAddBooks(bookIDs []int64, shelves []string) string {
 	var (
 		u, _ = url.Parse(shelvesAddURL)
 		v    = u.Query()
+		ids  = make([]string, len(bookIDs))
 	)
+	for i, id := range bookIDs {
+		ids[i] = fmt.Sprintf("%d", id)
+	}
 	v.Set("shelves", strings.Join(shelves, ","))
-	v.Set("bookids", fmt.Sprintf("%d", bookID))
+	v.Set("bookids", strings.Join(ids, ","))
 	u.RawQuery = v.Encode()
-
-	if _, err := c.apiRequest(u.String(), "POST"); err != nil {
-		return err
-	}
-
-	return nil
+	return u.String()
 }
 
 // AddToShelf adds a book to the specified shelf.
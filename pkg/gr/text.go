@@ -5,53 +5,388 @@
 package gr
 
 import (
+	"bytes"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/microcosm-cc/bluemonday"
+	"golang.org/x/net/html"
 )
 
 var (
-	rxEntityDec  = regexp.MustCompile(`&#\d+;`)
 	rxWhitespace = regexp.MustCompile(`\s+`)
-	mdPolicy     *bluemonday.Policy
 	textPolicy   *bluemonday.Policy
+
+	// characters with special meaning in inline Markdown
+	mdEscaper = strings.NewReplacer(
+		`\`, `\\`,
+		"&", `\&`,
+		"`", "\\`",
+		"*", `\*`,
+		"_", `\_`,
+		"[", `\[`,
+		"]", `\]`,
+		"<", `\<`,
+	)
 )
 
 func init() {
-	mdPolicy = bluemonday.NewPolicy()
-	mdPolicy.AllowElements("br", "i", "em", "strong", "b", "p")
 	textPolicy = bluemonday.NewPolicy()
 	textPolicy.AllowElements("br")
 }
 
-// HTML2Markdown converts HTML to Markdown.
+// HTML2Markdown converts HTML to (CommonMark) Markdown.
 func HTML2Markdown(s string) string {
-	tags := []struct {
-		find, repl string
-	}{
-		{"<p>", ""},
-		{"</p>", "\n\n"},
-		{"<br /><br />", "\n\n"},
-		{"<br/><br/>", "\n\n"},
-		{"<br />", "  \n"},
-		{"<br/>", "  \n"},
-		{"<i>", "*"},
-		{"</i>", "*"},
-		{"<em>", "*"},
-		{"</em>", "*"},
-		{"<strong>", "**"},
-		{"</strong>", "**"},
-		{"<b>", "**"},
-		{"</b>", "**"},
-	}
-	s = mdPolicy.Sanitize(s)
-	s = decodeEntities(tidyText(s))
-	for _, t := range tags {
-		s = strings.Replace(s, t.find, t.repl, -1)
+	var (
+		c = newMarkdownConverter()
+		z = html.NewTokenizer(strings.NewReader(s))
+	)
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return c.String()
+
+		case html.TextToken:
+			c.text(string(z.Text()))
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, more := z.TagName()
+			var href string
+			for more {
+				var k, v []byte
+				k, v, more = z.TagAttr()
+				if string(k) == "href" {
+					href = string(v)
+				}
+			}
+			c.start(string(name), href, tt == html.SelfClosingTagToken)
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			c.end(string(name))
+		}
 	}
-	return s
+}
+
+// break types
+const (
+	noBreak = iota
+	lineBreak
+	paraBreak
+)
+
+// inline element (emphasis or link) whose content is buffered until
+// it's closed, so whitespace can be moved outside the delimiters.
+type mdInline struct {
+	tag  string // "em", "strong" or "a"; empty for root
+	href string
+	buf  bytes.Buffer
+}
+
+type mdList struct {
+	ordered bool
+	n       int  // number of items
+	open    bool // whether an item is open
+}
+
+// markdownConverter builds Markdown from a stream of HTML tokens.
+type markdownConverter struct {
+	stack    []*mdInline // root + open inline elements
+	prefixes []string    // line prefixes of open blockquotes & list items
+	lists    []*mdList
+	pending  int    // break to insert before next text
+	blank    string // prefix of blank line between paragraphs
+	blankSet bool
+	marker   string // list item/heading marker to insert before next text
+	trim     bool   // whether to trim leading space from next text
+	bol      bool   // whether next text starts a line
+	skip     int    // > 0 inside <script> or <style>
+}
+
+func newMarkdownConverter() *markdownConverter {
+	return &markdownConverter{stack: []*mdInline{{}}, bol: true}
+}
+
+func (c *markdownConverter) start(tag, href string, selfClosing bool) {
+	switch tag {
+	case "br":
+		if c.pending < paraBreak {
+			c.breakBlock(c.pending + 1)
+		}
+	case "p", "div", "hr":
+		c.breakBlock(paraBreak)
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		c.breakBlock(paraBreak)
+		c.marker = c.prefix() + strings.Repeat("#", int(tag[1]-'0')) + " "
+	case "blockquote":
+		c.breakBlock(paraBreak)
+		c.prefixes = append(c.prefixes, "> ")
+	case "ul", "ol":
+		if len(c.lists) == 0 {
+			c.breakBlock(paraBreak)
+		}
+		c.lists = append(c.lists, &mdList{ordered: tag == "ol"})
+	case "li":
+		if len(c.lists) == 0 {
+			c.lists = append(c.lists, &mdList{})
+		}
+		l := c.lists[len(c.lists)-1]
+		if l.open { // previous item wasn't closed
+			c.popPrefix()
+		}
+		l.n++
+		l.open = true
+		marker := "- "
+		if l.ordered {
+			marker = strconv.Itoa(l.n) + ". "
+		}
+		c.breakBlock(lineBreak)
+		c.marker = c.prefix() + marker
+		c.prefixes = append(c.prefixes, strings.Repeat(" ", len(marker)))
+	case "i", "em", "cite":
+		if !selfClosing {
+			c.stack = append(c.stack, &mdInline{tag: "em"})
+		}
+	case "b", "strong":
+		if !selfClosing {
+			c.stack = append(c.stack, &mdInline{tag: "strong"})
+		}
+	case "a":
+		if !selfClosing {
+			c.stack = append(c.stack, &mdInline{tag: "a", href: href})
+		}
+	case "script", "style":
+		if !selfClosing {
+			c.skip++
+		}
+	}
+}
+
+func (c *markdownConverter) end(tag string) {
+	switch tag {
+	case "p", "div":
+		c.breakBlock(paraBreak)
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		c.breakBlock(paraBreak)
+		c.marker = ""
+	case "blockquote":
+		c.breakBlock(paraBreak)
+		c.popPrefix()
+	case "ul", "ol":
+		if len(c.lists) == 0 {
+			return
+		}
+		if c.lists[len(c.lists)-1].open {
+			c.popPrefix()
+		}
+		c.lists = c.lists[:len(c.lists)-1]
+		if len(c.lists) == 0 {
+			c.breakBlock(paraBreak)
+		} else {
+			c.breakBlock(lineBreak)
+		}
+	case "li":
+		if len(c.lists) > 0 && c.lists[len(c.lists)-1].open {
+			c.lists[len(c.lists)-1].open = false
+			c.popPrefix()
+		}
+	case "i", "em", "cite":
+		c.closeInline("em")
+	case "b", "strong":
+		c.closeInline("strong")
+	case "a":
+		c.closeInline("a")
+	case "script", "style":
+		if c.skip > 0 {
+			c.skip--
+		}
+	}
+}
+
+// add text to current inline element.
+func (c *markdownConverter) text(s string) {
+	if c.skip > 0 {
+		return
+	}
+	s = tidyText(strings.Replace(s, "\n", " ", -1))
+	if strings.TrimSpace(s) == "" {
+		if s != "" && !c.trim && c.pending == noBreak && c.marker == "" {
+			writeCollapsed(&c.top().buf, " ")
+		}
+		return
+	}
+	if c.pending != noBreak || c.marker != "" {
+		c.flushBreak()
+	}
+	if c.trim {
+		s = strings.TrimLeft(s, " ")
+		c.trim = false
+	}
+	s = mdEscaper.Replace(s)
+	if c.bol {
+		s = escapeBlockStart(s)
+		c.bol = false
+	}
+	writeCollapsed(&c.top().buf, s)
+}
+
+// request a block break before the next text.
+func (c *markdownConverter) breakBlock(n int) {
+	if n > c.pending {
+		c.pending = n
+	}
+	// use the shallowest prefix for the blank line, so a paragraph
+	// break at the start or end of a blockquote falls outside it
+	if n == paraBreak {
+		p := strings.TrimRight(c.prefix(), " ")
+		if !c.blankSet || len(p) < len(c.blank) {
+			c.blank, c.blankSet = p, true
+		}
+	}
+}
+
+// write pending break and marker. Open inline elements are closed before
+// the break and re-opened after it, so they don't span blocks.
+func (c *markdownConverter) flushBreak() {
+	var open []*mdInline
+	for len(c.stack) > 1 {
+		in := c.pop()
+		open = append([]*mdInline{{tag: in.tag, href: in.href}}, open...)
+	}
+
+	var (
+		root   = &c.stack[0].buf
+		prefix = c.prefix()
+		blank  = strings.TrimRight(prefix, " ")
+	)
+	if c.blankSet && len(c.blank) < len(blank) {
+		blank = c.blank
+	}
+	trimTrailingSpace(root)
+	if root.Len() > 0 {
+		switch c.pending {
+		case paraBreak:
+			root.WriteString("\n" + blank + "\n")
+		case lineBreak:
+			if c.marker == "" {
+				root.WriteString("  ")
+			}
+			root.WriteString("\n")
+		}
+		if c.marker == "" {
+			root.WriteString(prefix)
+		}
+	}
+	root.WriteString(c.marker)
+
+	c.pending, c.marker, c.trim, c.bol = noBreak, "", true, true
+	c.blank, c.blankSet = "", false
+	c.stack = append(c.stack, open...)
+}
+
+// close innermost inline element of given type and any elements within it.
+func (c *markdownConverter) closeInline(tag string) {
+	for i := len(c.stack) - 1; i > 0; i-- {
+		if c.stack[i].tag == tag {
+			for len(c.stack) > i {
+				c.pop()
+			}
+			return
+		}
+	}
+}
+
+// pop innermost inline element and write it to its parent.
+func (c *markdownConverter) pop() *mdInline {
+	in := c.top()
+	c.stack = c.stack[:len(c.stack)-1]
+
+	var (
+		parent  = &c.top().buf
+		content = in.buf.String()
+		s       = strings.TrimSpace(content)
+	)
+	if s == "" {
+		if content != "" {
+			writeCollapsed(parent, " ")
+		}
+		return in
+	}
+
+	if strings.HasPrefix(content, " ") {
+		writeCollapsed(parent, " ")
+	}
+	switch in.tag {
+	case "em":
+		s = "*" + s + "*"
+	case "strong":
+		s = "**" + s + "**"
+	case "a":
+		if in.href != "" && !strings.HasPrefix(strings.ToLower(in.href), "javascript:") {
+			s = "[" + s + "](" + markdownURL(in.href) + ")"
+		}
+	}
+	writeCollapsed(parent, s)
+	if strings.HasSuffix(content, " ") {
+		parent.WriteString(" ")
+	}
+	return in
+}
+
+func (c *markdownConverter) top() *mdInline { return c.stack[len(c.stack)-1] }
+func (c *markdownConverter) prefix() string { return strings.Join(c.prefixes, "") }
+
+func (c *markdownConverter) popPrefix() {
+	if len(c.prefixes) > 0 {
+		c.prefixes = c.prefixes[:len(c.prefixes)-1]
+	}
+}
+
+// String returns the Markdown.
+func (c *markdownConverter) String() string {
+	for len(c.stack) > 1 {
+		c.pop()
+	}
+	return strings.TrimSpace(c.stack[0].buf.String())
+}
+
+// write s to buf without doubling spaces.
+func writeCollapsed(buf *bytes.Buffer, s string) {
+	b := buf.Bytes()
+	if len(b) > 0 && b[len(b)-1] == ' ' {
+		s = strings.TrimLeft(s, " ")
+	}
+	buf.WriteString(s)
+}
+
+func trimTrailingSpace(buf *bytes.Buffer) {
+	b := buf.Bytes()
+	buf.Truncate(len(bytes.TrimRight(b, " ")))
+}
+
+// heading, blockquote and list markers at the start of a line
+var rxBlockStart = regexp.MustCompile(`^( *)(#|>|[-+](?: |$)|[0-9]{1,9}[.)](?: |$))`)
+
+// escape s if it starts with a block marker, so text at the start of
+// a line isn't turned into a heading, blockquote or list.
+func escapeBlockStart(s string) string {
+	m := rxBlockStart.FindStringSubmatchIndex(s)
+	if m == nil {
+		return s
+	}
+	i := m[3] // start of marker
+	if c := s[i]; c >= '0' && c <= '9' {
+		// escape the "." or ")" after the number
+		i = strings.IndexAny(s[i:], ".)") + i
+	}
+	return s[:i] + `\` + s[i:]
+}
+
+// escape characters that would end a Markdown link destination.
+func markdownURL(s string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(s)
 }
 
 var rxBR = regexp.MustCompile(`<br ?/>`)
@@ -59,21 +394,14 @@ var rxBR = regexp.MustCompile(`<br ?/>`)
 // HTML2Text converts HTML to plaintext.
 func HTML2Text(s string) string {
 	s = textPolicy.Sanitize(s)
-	s = decodeEntities(tidyText(s))
-	return rxBR.ReplaceAllString(s, "\n")
+	// replace tags before decoding, so escaped markup stays text
+	s = rxBR.ReplaceAllString(tidyText(s), "\n")
+	return decodeEntities(s)
 }
 
-// convert HTML &#NN; entities to text.
+// convert HTML entities (decimal, hex & named) to text in a single pass.
 func decodeEntities(s string) string {
-	for {
-		m := rxEntityDec.FindStringIndex(s)
-		if m == nil {
-			break
-		}
-		i, _ := strconv.ParseInt(s[m[0]+2:m[1]-1], 10, 64)
-		s = s[0:m[0]] + string(i) + s[m[1]:]
-	}
-	return strings.TrimSpace(s)
+	return strings.TrimSpace(html.UnescapeString(s))
 }
 
 // replace ASCII dashes and ellipses with Unicode versions; collapse whitespace.
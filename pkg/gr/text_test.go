@@ -31,7 +31,7 @@ So when the police bring him in to consult on a grisly double murder committed w
 
 Magic - it can get a guy killed.`},
 		{"dresden with link", `<i>An alternative cover edition with a different page count exists <a href="https://www.goodreads.com/book/show/13511897.here" title="here" rel="nofollow">here</a>.</i><br /><br />Harry Dresden - Wizard<br />Lost Items Found. Paranormal Investigations. Consulting. Advice. Reasonable Rates. No Love Potions, Endless Purses, or Other Entertainment.<br /><br />Harry Dresden has faced some pretty terrifying foes during his career. Giant scorpions. Oversexed vampires. Psychotic werewolves. It comes with the territory when you're the only professional wizard in the Chicago-area phone book.<br /><br />But in all Harry's years of supernatural sleuthing, he's never faced anything like this: The spirit world has gone postal. All over Chicago, ghosts are causing trouble - and not just of the door-slamming, boo-shouting variety. These ghosts are tormented, violent, and deadly. Someone - or <i>something</i> - is purposely stirring them up to wreak unearthly havoc. But why? And why do so many of the victims have ties to Harry? If Harry doesn't figure it out soon, he could wind up a ghost himself....`,
-			`*An alternative cover edition with a different page count exists here.*
+			`*An alternative cover edition with a different page count exists [here](https://www.goodreads.com/book/show/13511897.here).*
 
 Harry Dresden - Wizard  
 Lost Items Found. Paranormal Investigations. Consulting. Advice. Reasonable Rates. No Love Potions, Endless Purses, or Other Entertainment.
@@ -59,17 +59,35 @@ But in all Harry's years of supernatural sleuthing, he's never faced anything li
 			`Here, together for the first time, are the shorter from Jim Butcher's DRESDEN FILES series — a compendium of cases that Harry and his cadre of allies managed to close in record time. The tales range from the deadly serious to the absurdly hilarious. Also included is a new, never-before-published novella that takes place after the cliff-hanger ending of the new April 2010 hardcover, *Changes*.
 
 Contains:  
-+ "Restoration of Faith"  
-+ "Vignette"  
-+ "Something Borrowed" — from * My Big Fat Supernatural Wedding*  
-+ "It's My Birthday Too" — from * Many Bloody Returns*  
-+ "Heorot" — from * My Big Fat Supernatural Honeymoon*  
-+ "Day Off" — from * Blood Lite*  
-+ "Backup" — novelette from Thomas' point of view, originally published by Subterranean Press  
-+ "The Warrior" — novelette from * Mean Streets*  
-+ "Last Call" — from * Strange Brew*  
-+ "Love Hurts" — from * Songs of Love and Death*  
-+ *Aftermath* — all-new novella from Murphy's point of view, set forty-five minutes after the end of * Changes*`},
+\+ "Restoration of Faith"  
+\+ "Vignette"  
+\+ "Something Borrowed" — from *[My Big Fat Supernatural Wedding](https://www.goodreads.com/book/show/84156.My_Big_Fat_Supernatural_Wedding)*  
+\+ "It's My Birthday Too" — from *[Many Bloody Returns](https://www.goodreads.com/book/show/140098.Many_Bloody_Returns)*  
+\+ "Heorot" — from *[My Big Fat Supernatural Honeymoon](https://www.goodreads.com/book/show/1773616.My_Big_Fat_Supernatural_Honeymoon)*  
+\+ "Day Off" — from *[Blood Lite](https://www.goodreads.com/book/show/2871256.Blood_Lite)*  
+\+ "[Backup](https://www.goodreads.com/book/show/2575572.Backup)" — novelette from Thomas' point of view, originally published by Subterranean Press  
+\+ "The Warrior" — novelette from *[Mean Streets](https://www.goodreads.com/book/show/3475145.Mean_Streets)*  
+\+ "Last Call" — from *[Strange Brew](https://www.goodreads.com/book/show/6122181.Strange_Brew)*  
+\+ "Love Hurts" — from *[Songs of Love and Death](https://www.goodreads.com/book/show/7841656.Songs_of_Love_and_Death)*  
+\+ *Aftermath* — all-new novella from Murphy's point of view, set forty-five minutes after the end of *[Changes](https://www.goodreads.com/book/show/6585201.Changes)*`},
+		{"list", "<ul><li>One</li><li>Two<ul><li>Nested</li></ul></li><li>Three</li></ul>",
+			"- One\n- Two\n  - Nested\n- Three"},
+		{"ordered list", "<ol><li>first</li><li>second</li></ol>", "1. first\n2. second"},
+		{"blockquote", "<ul><li>Item</li></ul><blockquote><p>Quoted</p><p>Second para</p></blockquote>After",
+			"- Item\n\n> Quoted\n>\n> Second para\n\nAfter"},
+		{"heading", "<h2>Heading</h2><p>Text</p>", "## Heading\n\nText"},
+		{"emphasis across paragraphs", "<b>bold across<br><br>paragraphs</b> end.",
+			"**bold across**\n\n**paragraphs** end."},
+		{"escaping", "After &amp; &mdash; &rsquo; 5 * 3 [x] snake_case", "After \\& — ’ 5 \\* 3 \\[x\\] snake\\_case"},
+		{"ampersand", "Fish &amp; Chips &amp;copy; 2020", `Fish \& Chips \&copy; 2020`},
+		{"escape heading", "# Not a heading", `\# Not a heading`},
+		{"escape quote", "<p>Intro</p><p>> not quoted</p>", "Intro\n\n\\> not quoted"},
+		{"escape bullet", "Text<br>- not a list<br>+ nor this", "Text  \n\\- not a list  \n\\+ nor this"},
+		{"escape numbered", "<p>1984. A year</p><p>2) Second</p>", "1984\\. A year\n\n2\\) Second"},
+		{"escape in list", "<ul><li>- dash</li></ul>", "- \\- dash"},
+		{"no escape inline markers", "A - B # C > D 1. E", "A - B # C > D 1. E"},
+		{"no escape mid-line", "-5 degrees, #1 bestseller", "-5 degrees, #1 bestseller"},
+		{"script", "<p>Text</p><script>alert('x');</script>", "Text"},
 	}
 
 	for _, td := range tests {
@@ -140,6 +158,8 @@ Contains:
 + "Last Call" — from Strange Brew
 + "Love Hurts" — from Songs of Love and Death
 + Aftermath — all-new novella from Murphy's point of view, set forty-five minutes after the end of Changes`},
+		{"entities", "Caf&eacute; &amp; bar &#x2014; it&#8217;s <i>&lt;b&gt;</i>", "Café & bar — it’s <b>"},
+		{"escaped break", "one&lt;br /&gt;two<br />three", "one<br />two\nthree"},
 	}
 
 	for _, td := range tests {
@@ -149,3 +169,27 @@ Contains:
 		})
 	}
 }
+
+func TestDecodeEntities(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name, in, x string
+	}{
+		{"empty string", "", ""},
+		{"no entities", "plain text", "plain text"},
+		{"decimal", "it&#8217;s", "it’s"},
+		{"hex", "it&#x2019;s &#X2014;", "it’s —"},
+		{"named", "&amp; &mdash; &eacute;t&eacute; &hellip;", "& — été …"},
+		{"no semicolon", "&amp fish &copy 2020", "& fish © 2020"},
+		{"unknown", "&notanentity; &#xZZ;", "¬anentity; &#xZZ;"},
+		{"double-escaped", "&amp;eacute;", "&eacute;"},
+		{"adjacent", "&lt;&gt;&quot;&#39;", `<>"'`},
+	}
+
+	for _, td := range tests {
+		td := td
+		t.Run(td.name, func(t *testing.T) {
+			assert.Equal(t, td.x, decodeEntities(td.in), "unexpected text")
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+// Package jobs schedules background jobs that share a concurrency limit.
+//
+// A Scheduler's state is kept in a file, which is locked while it's
+// updated, so the workflow and its background jobs can share a Scheduler.
+package jobs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// job names are used as filenames
+var rxUnsafe = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// Name returns the name of the job that processes a specific resource,
+// e.g. "shelf.read.position" or "series.12345". Characters that aren't
+// safe in filenames are replaced.
+func Name(kind string, keys ...interface{}) string {
+	parts := []string{kind}
+	for _, k := range keys {
+		parts = append(parts, rxUnsafe.ReplaceAllString(fmt.Sprint(k), "_"))
+	}
+	return strings.Join(parts, ".")
+}
+
+// Job is a named background job.
+type Job struct {
+	Name string
+	Args []string // command-line arguments
+}
+
+// Scheduler runs at most Max jobs at once. Jobs started when Max jobs are
+// already running are queued, and started in order when running jobs
+// finish. Jobs that fail to start are logged and dropped.
+type Scheduler struct {
+	Path    string                 // state file
+	Max     int                    // maximum number of jobs to run at once
+	Running func(name string) bool // whether a job is still running
+	Start   func(j Job) error      // start a job in the background
+}
+
+// scheduler state
+type state struct {
+	Running []string // names of started jobs
+	Pending []Job    // jobs waiting to start
+}
+
+// Run starts job j, or queues it if Max jobs are already running. It
+// returns true if j is running.
+func (s *Scheduler) Run(j Job) (running bool, err error) {
+	err = s.update(func(st *state) {
+		if !s.Running(j.Name) && !pending(st.Pending, j.Name) {
+			st.Pending = append(st.Pending, j)
+		}
+	})
+	if err != nil {
+		return false, err
+	}
+	return s.Running(j.Name), nil
+}
+
+// Done starts queued jobs in the place of the finished job name. Jobs
+// that exit without calling Done free their place once they've stopped.
+func (s *Scheduler) Done(name string) error {
+	return s.update(func(st *state) { st.Running = remove(st.Running, name) })
+}
+
+// load state, call fn, start as many queued jobs as there's room for and
+// save state. The state file is locked throughout.
+func (s *Scheduler) update(fn func(st *state)) error {
+	f, err := os.OpenFile(s.Path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrap(err, "open scheduler")
+	}
+	defer f.Close()
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		return errors.Wrap(err, "lock scheduler")
+	}
+	defer syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return errors.Wrap(err, "read scheduler")
+	}
+	st := &state{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, st); err != nil {
+			return errors.Wrap(err, "parse scheduler")
+		}
+	}
+
+	// forget jobs that have stopped
+	var running []string
+	for _, name := range st.Running {
+		if s.Running(name) {
+			running = append(running, name)
+		}
+	}
+	st.Running = running
+
+	fn(st)
+
+	for len(st.Pending) > 0 && len(st.Running) < s.Max {
+		j := st.Pending[0]
+		st.Pending = st.Pending[1:]
+		// job may have been started outside the scheduler
+		if !s.Running(j.Name) {
+			// keep going, so jobs that have started are recorded
+			if err := s.Start(j); err != nil {
+				log.Printf("[jobs] start %q: %v", j.Name, err)
+				continue
+			}
+		}
+		st.Running = append(st.Running, j.Name)
+	}
+
+	if data, err = json.Marshal(st); err != nil {
+		return errors.Wrap(err, "update scheduler")
+	}
+	if err := f.Truncate(0); err != nil {
+		return errors.Wrap(err, "update scheduler")
+	}
+	if _, err := f.WriteAt(data, 0); err != nil {
+		return errors.Wrap(err, "update scheduler")
+	}
+	return nil
+}
+
+// returns true if a job called name is queued.
+func pending(jobs []Job, name string) bool {
+	for _, j := range jobs {
+		if j.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// names without name.
+func remove(names []string, name string) []string {
+	var other []string
+	for _, s := range names {
+		if s != name {
+			other = append(other, s)
+		}
+	}
+	return other
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2020 Dean Jackson <deanishe@deanishe.net>
+// MIT Licence applies http://opensource.org/licenses/MIT
+
+package jobs
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestName generates filename-safe job names
+func TestName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		kind string
+		keys []interface{}
+		x    string
+	}{
+		{"shelves", nil, "shelves"},
+		{"series", []interface{}{12345}, "series.12345"},
+		{"shelf", []interface{}{"to-read", "date_added"}, "shelf.to-read.date_added"},
+		{"shelf", []interface{}{"sci/fi & fantasy"}, "shelf.sci_fi_fantasy"},
+		{"shelf", []interface{}{"../read"}, "shelf._read"},
+	}
+
+	for _, td := range tests {
+		td := td
+		t.Run(td.x, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, td.x, Name(td.kind, td.keys...), "unexpected name")
+		})
+	}
+}
+
+// TestScheduler runs at most Max jobs and queues the rest
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "jobs-")
+	require.Nil(t, err, "create temp dir")
+	defer os.RemoveAll(dir)
+
+	var (
+		running = map[string]bool{}
+		started []string
+		s       = &Scheduler{
+			Path:    filepath.Join(dir, "jobs.json"),
+			Max:     2,
+			Running: func(name string) bool { return running[name] },
+			Start: func(j Job) error {
+				running[j.Name] = true
+				started = append(started, j.Name)
+				return nil
+			},
+		}
+	)
+	run := func(name string, x bool) {
+		v, err := s.Run(Job{Name: name})
+		require.Nil(t, err, "run %q", name)
+		assert.Equal(t, x, v, "unexpected running for %q", name)
+	}
+	done := func(name string) {
+		require.Nil(t, s.Done(name), "finish %q", name)
+		running[name] = false
+	}
+
+	run("a", true)
+	run("b", true)
+	run("c", false)
+	run("d", false)
+	run("a", true)  // already running
+	run("c", false) // already queued
+	assert.Equal(t, []string{"a", "b"}, started, "unexpected jobs started")
+
+	// finished job starts next in queue
+	done("a")
+	assert.Equal(t, []string{"a", "b", "c"}, started, "unexpected jobs started")
+
+	// job that stopped without calling Done frees its place
+	running["b"] = false
+	run("e", false)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, started, "unexpected jobs started")
+
+	// queued job started outside the scheduler isn't started again,
+	// but counts towards Max
+	running["e"] = true
+	done("c")
+	run("f", false)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, started, "unexpected jobs started")
+
+	done("d")
+	assert.Equal(t, []string{"a", "b", "c", "d", "f"}, started, "unexpected jobs started")
+}
+
+// TestSchedulerArgs starts each queued job with its own arguments, and
+// doesn't let a job that fails to start hold up the queue
+func TestSchedulerArgs(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "jobs-")
+	require.Nil(t, err, "create temp dir")
+	defer os.RemoveAll(dir)
+
+	var (
+		running = map[string]bool{}
+		started = map[string][]string{}
+		s       = &Scheduler{
+			Path:    filepath.Join(dir, "jobs.json"),
+			Max:     1,
+			Running: func(name string) bool { return running[name] },
+			Start: func(j Job) error {
+				if j.Name == "bad" {
+					return errors.New("no such program")
+				}
+				running[j.Name] = true
+				started[j.Name] = j.Args
+				return nil
+			},
+		}
+	)
+
+	_, err = s.Run(Job{"shelf.a", []string{"-saveshelf", "a", "position", "1"}})
+	require.Nil(t, err, "run shelf.a")
+	_, err = s.Run(Job{"bad", []string{"-savebook", "1"}})
+	require.Nil(t, err, "run bad")
+	_, err = s.Run(Job{"shelf.b", []string{"-saveshelf", "b", "date_added", "2"}})
+	require.Nil(t, err, "run shelf.b")
+	assert.Equal(t, map[string][]string{
+		"shelf.a": {"-saveshelf", "a", "position", "1"},
+	}, started, "unexpected jobs started")
+
+	require.Nil(t, s.Done("shelf.a"), "finish shelf.a")
+	running["shelf.a"] = false
+	assert.Equal(t, map[string][]string{
+		"shelf.a": {"-saveshelf", "a", "position", "1"},
+		"shelf.b": {"-saveshelf", "b", "date_added", "2"},
+	}, started, "unexpected jobs started")
+}